@@ -0,0 +1,101 @@
+package uinput
+
+import (
+	"fmt"
+	"math"
+	"os"
+	"sort"
+	"unsafe"
+)
+
+// AbsInfo describes an absolute axis.
+type AbsInfo struct {
+	// Min and Max are the range of the axis. If both are zero, the default range of the device is kept.
+	Min, Max int32
+
+	// Fuzz filters noise: the kernel drops changes smaller than fuzz.
+	Fuzz int32
+
+	// Flat is the dead zone around the center, values within it are treated as the center.
+	Flat int32
+
+	// Resolution of the axis in units per millimeter (units per radian for rotation axes). libinput
+	// needs it to know the physical size of a touchpad.
+	Resolution int32
+}
+
+// absAxes merges the axes given in a configuration into the default axes of a device. Only axes of
+// the device may be configured.
+func absAxes(defaults map[uint16]AbsInfo, config map[uint16]AbsInfo) (map[uint16]AbsInfo, error) {
+	axes := make(map[uint16]AbsInfo, len(defaults))
+	for code, info := range defaults {
+		axes[code] = info
+	}
+	for code, info := range config {
+		def, ok := defaults[code]
+		if !ok {
+			return nil, fmt.Errorf("the device has no absolute axis %#x", code)
+		}
+		if info.Min == 0 && info.Max == 0 {
+			info.Min, info.Max = def.Min, def.Max
+		}
+		if info.Min >= info.Max {
+			return nil, fmt.Errorf("axis %#x: minimum %d must be less than maximum %d", code, info.Min, info.Max)
+		}
+		if info.Fuzz < 0 || info.Flat < 0 || info.Resolution < 0 {
+			return nil, fmt.Errorf("axis %#x: fuzz, flat and resolution must not be negative", code)
+		}
+		if int64(info.Flat) > int64(info.Max)-int64(info.Min) {
+			return nil, fmt.Errorf("axis %#x: flat %d exceeds the range of the axis", code, info.Flat)
+		}
+		axes[code] = info
+	}
+	return axes, nil
+}
+
+// applyAbsInfo sets the range, fuzz and flat of the axes in the device struct.
+func applyAbsInfo(dev *uinputUserDev, axes map[uint16]AbsInfo) {
+	for code, info := range axes {
+		dev.Absmin[code] = info.Min
+		dev.Absmax[code] = info.Max
+		dev.Absfuzz[code] = info.Fuzz
+		dev.Absflat[code] = info.Flat
+	}
+}
+
+// setupAbsResolution sets up the axes that have a resolution. The device struct has no field for it,
+// so UI_ABS_SETUP (Linux 4.5 and later) is used after the struct was written. Devices without
+// resolution don't need it and keep working on older kernels.
+func setupAbsResolution(deviceFile *os.File, axes map[uint16]AbsInfo) error {
+	codes := make([]int, 0, len(axes))
+	for code, info := range axes {
+		if info.Resolution != 0 {
+			codes = append(codes, int(code))
+		}
+	}
+	sort.Ints(codes)
+	for _, code := range codes {
+		info := axes[uint16(code)]
+		setup := uinputAbsSetup{
+			Code: uint16(code),
+			Absinfo: inputAbsinfo{
+				Minimum:    info.Min,
+				Maximum:    info.Max,
+				Fuzz:       info.Fuzz,
+				Flat:       info.Flat,
+				Resolution: info.Resolution,
+			},
+		}
+		err := ioctl(deviceFile, uiAbsSetup, uintptr(unsafe.Pointer(&setup)))
+		if err != nil {
+			return fmt.Errorf("failed to set up absolute axis %#x: %v", code, err)
+		}
+	}
+	return nil
+}
+
+// denormalizeAxis maps a value from -1 to 1 onto the range of the axis.
+func denormalizeAxis(info AbsInfo, value float32) int32 {
+	center := (float64(info.Min) + float64(info.Max)) / 2
+	return int32(math.Round(center + float64(value)*(float64(info.Max)-float64(info.Min))/2))
+}
@@ -0,0 +1,139 @@
+package uinput
+
+import (
+	"testing"
+	"unsafe"
+)
+
+func TestAbsAxesMergesConfiguredAxes(t *testing.T) {
+	defaults := map[uint16]AbsInfo{
+		AbsX: {Min: 0, Max: 1024},
+		AbsY: {Min: 0, Max: 768},
+	}
+	axes, err := absAxes(defaults, map[uint16]AbsInfo{
+		AbsX: {Fuzz: 4, Resolution: 12},
+		AbsY: {Min: -100, Max: 100, Flat: 10},
+	})
+	if err != nil {
+		t.Fatalf("Failed to merge the axes. Last error was: %s\n", err)
+	}
+	if x := axes[AbsX]; x != (AbsInfo{Min: 0, Max: 1024, Fuzz: 4, Resolution: 12}) {
+		t.Fatalf("Expected the default range to be kept, got %+v", x)
+	}
+	if y := axes[AbsY]; y != (AbsInfo{Min: -100, Max: 100, Flat: 10}) {
+		t.Fatalf("Expected the configured range, got %+v", y)
+	}
+	if defaults[AbsX].Fuzz != 0 {
+		t.Fatalf("Expected the defaults to be left alone")
+	}
+
+	for name, config := range map[string]map[uint16]AbsInfo{
+		"unknown axis":      {AbsZ: {Min: 0, Max: 1}},
+		"empty range":       {AbsX: {Min: 5, Max: 5}},
+		"negative fuzz":     {AbsX: {Fuzz: -1}},
+		"flat beyond range": {AbsY: {Flat: 1000}},
+	} {
+		if _, err := absAxes(defaults, config); err == nil {
+			t.Errorf("%s: expected the configuration to be invalid", name)
+		}
+	}
+}
+
+func TestApplyAbsInfoFillsTheDeviceStruct(t *testing.T) {
+	var dev uinputUserDev
+	applyAbsInfo(&dev, map[uint16]AbsInfo{AbsRx: {Min: -512, Max: 511, Fuzz: 2, Flat: 16, Resolution: 3}})
+	if dev.Absmin[AbsRx] != -512 || dev.Absmax[AbsRx] != 511 || dev.Absfuzz[AbsRx] != 2 || dev.Absflat[AbsRx] != 16 {
+		t.Fatalf("Unexpected axis in the device struct: min %d, max %d, fuzz %d, flat %d",
+			dev.Absmin[AbsRx], dev.Absmax[AbsRx], dev.Absfuzz[AbsRx], dev.Absflat[AbsRx])
+	}
+}
+
+func TestAbsSetupMatchesTheKernelStruct(t *testing.T) {
+	// struct uinput_abs_setup is a __u16 code followed by struct input_absinfo (6 * __s32)
+	if size := unsafe.Sizeof(uinputAbsSetup{}); size != 28 || uiAbsSetup>>16&0x3fff != 28 {
+		t.Fatalf("Expected a size of 28 bytes, got %d", size)
+	}
+}
+
+func TestGamepadMapsSticksOntoConfiguredRange(t *testing.T) {
+	file := createTestDeviceFile(t)
+	defer removeTestDeviceFile(file)
+	vg := vGamepad{deviceFile: file, state: newInputState(), axes: map[uint16]AbsInfo{
+		AbsX: {Min: 0, Max: 255, Flat: 15},
+		AbsY: {Flat: 15},
+	}}
+
+	for _, value := range []float32{-1, 0, 1} {
+		if err := vg.sendStickAxisEvent(AbsX, value); err != nil {
+			t.Fatalf("Failed to move the stick. Last error was: %s\n", err)
+		}
+	}
+	if err := vg.sendStickAxisEvent(AbsY, 0.5); err != nil {
+		t.Fatalf("Failed to move the stick. Last error was: %s\n", err)
+	}
+	assertEvents(t, readTestEvents(t, file), []inputEvent{
+		{Type: EvAbs, Code: AbsX, Value: 0},
+		{Type: EvAbs, Code: AbsX, Value: 128},
+		{Type: EvAbs, Code: AbsX, Value: 255},
+		// the default range is kept if only the flat is configured
+		{Type: EvAbs, Code: AbsY, Value: denormalizeInput(0.5)},
+	})
+}
+
+func TestAbsDeviceCreationFailsOnInvalidAxes(t *testing.T) {
+	if _, err := CreateTouchPadWithConfig("/dev/null", []byte("TouchPad"), TouchPadConfig{
+		MaxX: 100, MaxY: 100, Axes: map[uint16]AbsInfo{AbsZ: {Resolution: 10}},
+	}); err == nil {
+		t.Errorf("Expected a touchpad with an unknown axis to fail")
+	}
+	if _, err := CreateMultiTouchWithConfig("/dev/null", []byte("MultiTouch"), MultiTouchConfig{
+		MaxX: 100, MaxY: 100, MaxContacts: 2, Axes: map[uint16]AbsInfo{AbsMtPositionX: {Fuzz: -1}},
+	}); err == nil {
+		t.Errorf("Expected a multitouch device with a negative fuzz to fail")
+	}
+	if _, err := CreateGamepadWithConfig("/dev/null", []byte("Gamepad"), GamepadConfig{
+		Axes: map[uint16]AbsInfo{AbsX: {Min: 10, Max: -10}},
+	}); err == nil {
+		t.Errorf("Expected a gamepad with an inverted range to fail")
+	}
+}
+
+func TestGamepadReleasesAxesToTheCenterOfTheirRange(t *testing.T) {
+	file := createTestDeviceFile(t)
+	defer removeTestDeviceFile(file)
+	vg := vGamepad{deviceFile: file, state: newInputState(), axes: map[uint16]AbsInfo{
+		AbsX:     {Min: 0, Max: 255},
+		AbsY:     {Min: 0, Max: 255},
+		AbsHat0X: {Min: 0, Max: 2},
+	}}
+	vg.setNeutralAxes()
+
+	if err := vg.sendStickEvent(map[uint16]float32{AbsX: 1, AbsY: 0}); err != nil {
+		t.Fatalf("Failed to move the stick. Last error was: %s\n", err)
+	}
+	if err := vg.HatPress(HatRight); err != nil {
+		t.Fatalf("Failed to press the hat. Last error was: %s\n", err)
+	}
+	if hat, _ := vg.state.axisValue(AbsHat0X); hat != 2 {
+		t.Fatalf("Expected the hat to be pressed to the maximum of its range, got %d", hat)
+	}
+	if err := vg.ReleaseAll(); err != nil {
+		t.Fatalf("Failed to release. Last error was: %s\n", err)
+	}
+
+	frames := readTestFrames(t, file)
+	// the centered y-axis is left alone, x and the hat go back to their rest position
+	assertEvents(t, frames[len(frames)-1], []inputEvent{
+		{Type: EvAbs, Code: AbsX, Value: 128},
+		{Type: EvAbs, Code: AbsHat0X, Value: 1},
+	})
+	if x, _ := vg.state.axisValue(AbsX); x != 128 {
+		t.Fatalf("Expected the x-axis to be tracked at its center, got %d", x)
+	}
+	if err := vg.ReleaseAll(); err != nil {
+		t.Fatalf("Failed to release. Last error was: %s\n", err)
+	}
+	if n := len(readTestFrames(t, file)); n != len(frames) {
+		t.Fatalf("Expected nothing to be sent for axes at rest, got %d new frames", n-len(frames))
+	}
+}
@@ -0,0 +1,246 @@
+package uinput
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"sync"
+	"time"
+)
+
+// An AccelerationProfile maps the speed of the pointer in pixels per second to the gain the
+// movement is multiplied with.
+type AccelerationProfile interface {
+	Gain(speed float64) float64
+}
+
+// AccelerationFunc turns a function into an AccelerationProfile.
+type AccelerationFunc func(speed float64) float64
+
+// Gain returns f(speed).
+func (f AccelerationFunc) Gain(speed float64) float64 {
+	return f(speed)
+}
+
+// LinearAcceleration increases the gain linearly with the speed above the threshold.
+type LinearAcceleration struct {
+	// Threshold is the speed (pixels per second) up to which the gain is 1.
+	Threshold float64
+
+	// Slope is the increase of the gain per pixel per second above the threshold.
+	Slope float64
+
+	// MaxGain limits the gain, unlimited if zero.
+	MaxGain float64
+}
+
+// Gain returns the gain for the given speed.
+func (l LinearAcceleration) Gain(speed float64) float64 {
+	if speed <= l.Threshold {
+		return 1
+	}
+	return limitGain(1+l.Slope*(speed-l.Threshold), l.MaxGain)
+}
+
+// PowerAcceleration increases the gain with the power of the speed relative to the threshold,
+// so fast movements are accelerated more than linearly for exponents above 1.
+type PowerAcceleration struct {
+	// Threshold is the speed (pixels per second) up to which the gain is 1. Must be positive.
+	Threshold float64
+
+	// Exponent of the curve.
+	Exponent float64
+
+	// MaxGain limits the gain, unlimited if zero.
+	MaxGain float64
+}
+
+// Gain returns the gain for the given speed.
+func (p PowerAcceleration) Gain(speed float64) float64 {
+	if p.Threshold <= 0 || speed <= p.Threshold {
+		return 1
+	}
+	return limitGain(math.Pow(speed/p.Threshold, p.Exponent), p.MaxGain)
+}
+
+// An AccelerationPoint is a point of a PointsAcceleration curve.
+type AccelerationPoint struct {
+	Speed float64
+	Gain  float64
+}
+
+// PointsAcceleration is a custom curve. The gain is interpolated linearly between the points
+// and is the gain of the first or last point for speeds outside of the curve. Gain expects the
+// points sorted by speed; Accelerate and SetProfile sort a copy of them.
+type PointsAcceleration []AccelerationPoint
+
+// Gain returns the gain for the given speed.
+func (p PointsAcceleration) Gain(speed float64) float64 {
+	if len(p) == 0 {
+		return 1
+	}
+	i := sort.Search(len(p), func(i int) bool { return p[i].Speed >= speed })
+	if i == 0 {
+		return p[0].Gain
+	}
+	if i == len(p) {
+		return p[len(p)-1].Gain
+	}
+	a, b := p[i-1], p[i]
+	return a.Gain + (b.Gain-a.Gain)*(speed-a.Speed)/(b.Speed-a.Speed)
+}
+
+// sortedProfile returns a copy of the points of a PointsAcceleration sorted by speed, so the
+// caller's slice is left alone and Gain doesn't have to sort on every movement.
+func sortedProfile(profile AccelerationProfile) AccelerationProfile {
+	points, ok := profile.(PointsAcceleration)
+	if !ok {
+		return profile
+	}
+	sorted := make(PointsAcceleration, len(points))
+	copy(sorted, points)
+	sort.SliceStable(sorted, func(i, j int) bool { return sorted[i].Speed < sorted[j].Speed })
+	return sorted
+}
+
+func limitGain(gain, max float64) float64 {
+	if max > 0 && gain > max {
+		return max
+	}
+	return gain
+}
+
+const (
+	// shortest time between two movements used for the speed, so bursts don't result in huge speeds
+	minAccelerationInterval = time.Millisecond
+	// longest time between two movements used for the speed, the first movement after a pause is
+	// treated as coming after this interval
+	maxAccelerationInterval = 100 * time.Millisecond
+)
+
+// An Accelerator is a Mouse that applies a pointer acceleration profile to the movements of the
+// mouse it wraps. The speed is computed from the distance and the time since the previous movement,
+// the movement is multiplied with the gain of the profile and sent with MoveFloat, so no fractions
+// are lost. Every movement is accelerated: MoveLeft, MoveRight, MoveUp, MoveDown, Move, MoveFloat,
+// and the steps of MoveSmooth and Drag, which therefore end where the accelerated steps add up to.
+// Buttons, the wheel and Close are passed on to the mouse unchanged.
+type Accelerator struct {
+	Mouse
+	now   func() time.Time
+	sleep func(time.Duration)
+
+	mu      sync.Mutex
+	profile AccelerationProfile
+	last    time.Time
+}
+
+// Accelerate creates an Accelerator that moves the mouse. Use the Accelerator in place of the
+// mouse.
+func Accelerate(mouse Mouse, profile AccelerationProfile) (*Accelerator, error) {
+	if mouse == nil {
+		return nil, fmt.Errorf("mouse must not be nil")
+	}
+	if profile == nil {
+		return nil, fmt.Errorf("acceleration profile must not be nil")
+	}
+	return &Accelerator{Mouse: mouse, profile: sortedProfile(profile), now: time.Now, sleep: time.Sleep}, nil
+}
+
+// SetProfile replaces the acceleration profile. The profile must not be nil.
+func (a *Accelerator) SetProfile(profile AccelerationProfile) error {
+	if profile == nil {
+		return fmt.Errorf("acceleration profile must not be nil")
+	}
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	a.profile = sortedProfile(profile)
+	return nil
+}
+
+// MoveLeft moves the pointer left by pixel multiplied with the gain for the current speed.
+func (a *Accelerator) MoveLeft(pixel int32) error {
+	if err := assertNotNegative(pixel); err != nil {
+		return err
+	}
+	return a.MoveFloat(-float64(pixel), 0)
+}
+
+// MoveRight moves the pointer right by pixel multiplied with the gain for the current speed.
+func (a *Accelerator) MoveRight(pixel int32) error {
+	if err := assertNotNegative(pixel); err != nil {
+		return err
+	}
+	return a.MoveFloat(float64(pixel), 0)
+}
+
+// MoveUp moves the pointer up by pixel multiplied with the gain for the current speed.
+func (a *Accelerator) MoveUp(pixel int32) error {
+	if err := assertNotNegative(pixel); err != nil {
+		return err
+	}
+	return a.MoveFloat(0, -float64(pixel))
+}
+
+// MoveDown moves the pointer down by pixel multiplied with the gain for the current speed.
+func (a *Accelerator) MoveDown(pixel int32) error {
+	if err := assertNotNegative(pixel); err != nil {
+		return err
+	}
+	return a.MoveFloat(0, float64(pixel))
+}
+
+// Move moves the pointer by x and y pixels multiplied with the gain for the current speed.
+func (a *Accelerator) Move(x, y int32) error {
+	return a.MoveFloat(float64(x), float64(y))
+}
+
+// MoveFloat moves the pointer by dx and dy pixels multiplied with the gain for the current speed.
+func (a *Accelerator) MoveFloat(dx, dy float64) error {
+	a.mu.Lock()
+	now := a.now()
+	interval := maxAccelerationInterval
+	if !a.last.IsZero() {
+		interval = now.Sub(a.last)
+	}
+	a.last = now
+	if interval < minAccelerationInterval {
+		interval = minAccelerationInterval
+	}
+	if interval > maxAccelerationInterval {
+		interval = maxAccelerationInterval
+	}
+	speed := math.Hypot(dx, dy) / interval.Seconds()
+	gain := a.profile.Gain(speed)
+	a.mu.Unlock()
+
+	return a.Mouse.MoveFloat(dx*gain, dy*gain)
+}
+
+// MoveSmooth moves the pointer by dx and dy in steps of smoothMoveInterval, every step is
+// accelerated for the speed it is sent at.
+func (a *Accelerator) MoveSmooth(dx, dy int32, duration time.Duration, easing Easing) error {
+	var sentX, sentY int32
+	return smoothSteps(dx, dy, duration, easing, a.sleep, func(x, y int32) error {
+		if x == sentX && y == sentY {
+			return nil
+		}
+		if err := a.MoveFloat(float64(x-sentX), float64(y-sentY)); err != nil {
+			return err
+		}
+		sentX, sentY = x, y
+		return nil
+	})
+}
+
+// Drag moves the pointer like MoveSmooth while the button is held down.
+func (a *Accelerator) Drag(dx, dy int32, options DragOptions) error {
+	options = options.withDefaults()
+	return dragWith(func(btnState int) error {
+		if btnState == btnStatePressed {
+			return a.Mouse.Press(options.Button)
+		}
+		return a.Mouse.Release(options.Button)
+	}, options, a.sleep, func() error {
+		return a.MoveSmooth(dx, dy, options.Duration, options.Easing)
+	})
+}
@@ -0,0 +1,144 @@
+package uinput
+
+import (
+	"math"
+	"testing"
+	"time"
+)
+
+func TestAccelerationProfiles(t *testing.T) {
+	linear := LinearAcceleration{Threshold: 100, Slope: 0.01, MaxGain: 3}
+	power := PowerAcceleration{Threshold: 100, Exponent: 0.5}
+	points := PointsAcceleration{{Speed: 0, Gain: 0.5}, {Speed: 200, Gain: 1}, {Speed: 1000, Gain: 4}}
+
+	for _, c := range []struct {
+		name     string
+		profile  AccelerationProfile
+		speed    float64
+		expected float64
+	}{
+		{"linear below threshold", linear, 50, 1},
+		{"linear above threshold", linear, 150, 1.5},
+		{"linear limited", linear, 1000, 3},
+		{"power below threshold", power, 100, 1},
+		{"power above threshold", power, 400, 2},
+		{"points below curve", points, -1, 0.5},
+		{"points interpolated", points, 100, 0.75},
+		{"points interpolated between later points", points, 600, 2.5},
+		{"points above curve", points, 5000, 4},
+		{"func", AccelerationFunc(func(speed float64) float64 { return speed / 10 }), 20, 2},
+	} {
+		if gain := c.profile.Gain(c.speed); math.Abs(gain-c.expected) > 1e-9 {
+			t.Errorf("%s: expected a gain of %v, got %v", c.name, c.expected, gain)
+		}
+	}
+}
+
+func TestAcceleratorSortsPointsOnce(t *testing.T) {
+	points := PointsAcceleration{{Speed: 1000, Gain: 4}, {Speed: 0, Gain: 0.5}, {Speed: 200, Gain: 1}}
+
+	accelerator, err := Accelerate(vMouse{}, points)
+	if err != nil {
+		t.Fatalf("Failed to create the accelerator. Last error was: %s\n", err)
+	}
+	if gain := accelerator.profile.Gain(600); math.Abs(gain-2.5) > 1e-9 {
+		t.Fatalf("Expected a gain of 2.5, got %v", gain)
+	}
+	if points[0].Speed != 1000 {
+		t.Fatalf("Expected the caller's points to be left unsorted")
+	}
+
+	if err := accelerator.SetProfile(points); err != nil {
+		t.Fatalf("Failed to set the profile. Last error was: %s\n", err)
+	}
+	if gain := accelerator.profile.Gain(100); math.Abs(gain-0.75) > 1e-9 {
+		t.Fatalf("Expected a gain of 0.75, got %v", gain)
+	}
+}
+
+func TestAcceleratorGainDependsOnEventTiming(t *testing.T) {
+	file := createTestDeviceFile(t)
+	defer removeTestDeviceFile(file)
+	mouse := vMouse{deviceFile: file, state: newInputState(), motion: &motionRemainder{}}
+
+	accelerator, err := Accelerate(mouse, LinearAcceleration{Threshold: 500, Slope: 0.001})
+	if err != nil {
+		t.Fatalf("Failed to create the accelerator. Last error was: %s\n", err)
+	}
+	now := time.Unix(0, 0)
+	accelerator.now = func() time.Time { return now }
+
+	// the first movement is treated as coming after a pause: 10px in 100ms is slow
+	if err := accelerator.Move(10, 0); err != nil {
+		t.Fatalf("Failed to move. Last error was: %s\n", err)
+	}
+	// 10px in 10ms is 1000px/s, a gain of 1.5
+	now = now.Add(10 * time.Millisecond)
+	if err := accelerator.Move(10, 0); err != nil {
+		t.Fatalf("Failed to move. Last error was: %s\n", err)
+	}
+	// 0.4s later the pointer is slow again
+	now = now.Add(400 * time.Millisecond)
+	if err := accelerator.Move(0, -20); err != nil {
+		t.Fatalf("Failed to move. Last error was: %s\n", err)
+	}
+
+	assertEvents(t, readTestEvents(t, file), []inputEvent{
+		{Type: EvRel, Code: RelX, Value: 10},
+		{Type: EvRel, Code: RelX, Value: 15},
+		{Type: EvRel, Code: RelY, Value: -20},
+	})
+}
+
+func TestAcceleratorAcceleratesEveryMovement(t *testing.T) {
+	file := createTestDeviceFile(t)
+	defer removeTestDeviceFile(file)
+	mouse := vMouse{deviceFile: file, state: newInputState(), motion: &motionRemainder{}}
+
+	accelerator, err := Accelerate(mouse, AccelerationFunc(func(speed float64) float64 { return 2 }))
+	if err != nil {
+		t.Fatalf("Failed to create the accelerator. Last error was: %s\n", err)
+	}
+	accelerator.sleep = func(time.Duration) {}
+	var accelerated Mouse = accelerator
+
+	if err := accelerated.MoveLeft(3); err != nil {
+		t.Fatalf("Failed to move left. Last error was: %s\n", err)
+	}
+	if err := accelerated.MoveDown(4); err != nil {
+		t.Fatalf("Failed to move down. Last error was: %s\n", err)
+	}
+	if err := accelerated.MoveSmooth(10, 0, 2*smoothMoveInterval, EaseLinear); err != nil {
+		t.Fatalf("Failed to move smoothly. Last error was: %s\n", err)
+	}
+	if err := accelerated.Drag(0, 1, DragOptions{}); err != nil {
+		t.Fatalf("Failed to drag. Last error was: %s\n", err)
+	}
+
+	assertEvents(t, readTestEvents(t, file), []inputEvent{
+		{Type: EvRel, Code: RelX, Value: -6},
+		{Type: EvRel, Code: RelY, Value: 8},
+		{Type: EvRel, Code: RelX, Value: 10},
+		{Type: EvRel, Code: RelX, Value: 10},
+		{Type: EvKey, Code: BtnLeft, Value: btnStatePressed},
+		{Type: EvRel, Code: RelY, Value: 2},
+		{Type: EvKey, Code: BtnLeft, Value: btnStateReleased},
+	})
+}
+
+func TestAccelerateFailsWithoutProfile(t *testing.T) {
+	if _, err := Accelerate(vMouse{}, nil); err == nil {
+		t.Fatalf("Expected creating an accelerator without profile to fail")
+	}
+
+	accelerator, err := Accelerate(vMouse{}, LinearAcceleration{})
+	if err != nil {
+		t.Fatalf("Failed to create the accelerator. Last error was: %s\n", err)
+	}
+	if err := accelerator.SetProfile(nil); err == nil {
+		t.Fatalf("Expected setting a nil profile to fail")
+	}
+	if accelerator.profile == nil {
+		t.Fatalf("Expected the previous profile to be kept")
+	}
+}
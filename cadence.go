@@ -0,0 +1,120 @@
+package uinput
+
+import (
+	"encoding/binary"
+	"hash/fnv"
+	"math/rand"
+	"time"
+)
+
+// A TypingCadence makes a keyboard type like a human instead of sending every press and release
+// back-to-back. It is set using KeyboardConfig.Cadence and applies to KeyPress, KeyDown and
+// everything built on them (TypeString, KeyChord, PressShortcut). Given the same Seed, the
+// keyboard produces the same timing every time.
+type TypingCadence struct {
+	// Hold is the time a key is held down by KeyPress.
+	Hold time.Duration
+
+	// Delay is the time between two key presses. A key is pressed immediately if more time has
+	// passed since the last one.
+	Delay time.Duration
+
+	// WordsPerMinute sets Delay to match the given typing speed (a word being five keys).
+	// It takes precedence over Delay if set.
+	WordsPerMinute float64
+
+	// Jitter randomly varies every hold time and delay by up to the given fraction, e.g. 0.2
+	// for ±20%.
+	Jitter float64
+
+	// Seed seeds the random jitter and bigram variations.
+	Seed int64
+
+	// BigramVariation gives every pair of keys its own constant delay factor of up to the given
+	// fraction (derived from Seed), as people type some key pairs faster than others.
+	BigramVariation float64
+
+	// Bigrams sets the delay factor of specific key pairs, e.g. {KeyT, KeyH}: 0.6 types "th"
+	// faster. It replaces BigramVariation for these pairs.
+	Bigrams map[[2]int]float64
+}
+
+// average number of key presses per word used by typing speeds
+const keysPerWord = 5
+
+// typingTimer keeps track of the timing of a keyboard with a typing cadence.
+type typingTimer struct {
+	cadence  TypingCadence
+	rand     *rand.Rand
+	lastKey  int
+	lastDown time.Time
+	now      func() time.Time
+	sleep    func(time.Duration)
+}
+
+func newTypingTimer(cadence TypingCadence) *typingTimer {
+	return &typingTimer{
+		cadence: cadence,
+		rand:    rand.New(rand.NewSource(cadence.Seed)),
+		lastKey: -1,
+		now:     time.Now,
+		sleep:   time.Sleep,
+	}
+}
+
+// waitForKey waits until the given key can be pressed and records the press.
+func (t *typingTimer) waitForKey(key int) {
+	if t.lastKey >= 0 {
+		delay := t.jitter(time.Duration(float64(t.delay()) * t.bigramFactor(t.lastKey, key)))
+		if wait := t.lastDown.Add(delay).Sub(t.now()); wait > 0 {
+			t.sleep(wait)
+		}
+	}
+	t.lastKey = key
+	t.lastDown = t.now()
+}
+
+// hold waits for the time a key is held down.
+func (t *typingTimer) hold() {
+	if hold := t.jitter(t.cadence.Hold); hold > 0 {
+		t.sleep(hold)
+	}
+}
+
+func (t *typingTimer) delay() time.Duration {
+	if t.cadence.WordsPerMinute > 0 {
+		return time.Duration(float64(time.Minute) / (t.cadence.WordsPerMinute * keysPerWord))
+	}
+	return t.cadence.Delay
+}
+
+// jitter randomly varies d by up to the configured fraction.
+func (t *typingTimer) jitter(d time.Duration) time.Duration {
+	if t.cadence.Jitter <= 0 || d <= 0 {
+		return d
+	}
+	d = time.Duration(float64(d) * (1 + t.cadence.Jitter*(2*t.rand.Float64()-1)))
+	if d < 0 {
+		return 0
+	}
+	return d
+}
+
+// bigramFactor returns the delay factor of typing key after prev. Without an explicit factor the
+// factor is derived from a hash of the seed and both keys, so it is the same for every occurrence.
+func (t *typingTimer) bigramFactor(prev, key int) float64 {
+	if factor, ok := t.cadence.Bigrams[[2]int{prev, key}]; ok {
+		return factor
+	}
+	if t.cadence.BigramVariation <= 0 {
+		return 1
+	}
+	var buf [24]byte
+	binary.LittleEndian.PutUint64(buf[0:], uint64(t.cadence.Seed))
+	binary.LittleEndian.PutUint64(buf[8:], uint64(prev))
+	binary.LittleEndian.PutUint64(buf[16:], uint64(key))
+	h := fnv.New64a()
+	_, _ = h.Write(buf[:])
+	r := float64(h.Sum64()>>11) / (1 << 53)
+	return 1 + t.cadence.BigramVariation*(2*r-1)
+}
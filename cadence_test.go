@@ -0,0 +1,121 @@
+package uinput
+
+import (
+	"testing"
+	"time"
+)
+
+// fakeClock replaces the clock of a typing timer and records every sleep.
+type fakeClock struct {
+	now    time.Time
+	sleeps []time.Duration
+}
+
+func (c *fakeClock) install(t *typingTimer) {
+	t.now = func() time.Time { return c.now }
+	t.sleep = func(d time.Duration) {
+		c.sleeps = append(c.sleeps, d)
+		c.now = c.now.Add(d)
+	}
+}
+
+func typeWithTimer(cadence TypingCadence, keys ...int) []time.Duration {
+	timer := newTypingTimer(cadence)
+	clock := &fakeClock{now: time.Unix(0, 0)}
+	clock.install(timer)
+	for _, key := range keys {
+		timer.waitForKey(key)
+		timer.hold()
+	}
+	return clock.sleeps
+}
+
+func assertDurations(t *testing.T, actual, expected []time.Duration) {
+	if len(actual) != len(expected) {
+		t.Fatalf("Expected: %v\nActual: %v", expected, actual)
+	}
+	for i := range expected {
+		if actual[i] != expected[i] {
+			t.Fatalf("Expected: %v\nActual: %v", expected, actual)
+		}
+	}
+}
+
+func TestTypingCadenceWaitsBetweenKeys(t *testing.T) {
+	sleeps := typeWithTimer(TypingCadence{Hold: 30 * time.Millisecond, Delay: 100 * time.Millisecond}, KeyA, KeyB, KeyC)
+
+	// the hold time counts towards the delay to the next key
+	expected := []time.Duration{30 * time.Millisecond, 70 * time.Millisecond, 30 * time.Millisecond, 70 * time.Millisecond, 30 * time.Millisecond}
+	assertDurations(t, sleeps, expected)
+}
+
+func TestTypingCadenceWordsPerMinute(t *testing.T) {
+	sleeps := typeWithTimer(TypingCadence{WordsPerMinute: 120, Delay: time.Second}, KeyA, KeyB)
+
+	// 120 words of 5 keys per minute are 100ms per key
+	assertDurations(t, sleeps, []time.Duration{100 * time.Millisecond})
+}
+
+func TestTypingCadenceBigrams(t *testing.T) {
+	cadence := TypingCadence{Delay: 100 * time.Millisecond, Bigrams: map[[2]int]float64{{KeyT, KeyH}: 0.5}}
+	sleeps := typeWithTimer(cadence, KeyT, KeyH, KeyE)
+
+	assertDurations(t, sleeps, []time.Duration{50 * time.Millisecond, 100 * time.Millisecond})
+}
+
+func TestTypingCadenceIsReproducible(t *testing.T) {
+	cadence := TypingCadence{Hold: 50 * time.Millisecond, WordsPerMinute: 60, Jitter: 0.3, BigramVariation: 0.2, Seed: 42}
+	keys := []int{KeyH, KeyE, KeyL, KeyL, KeyO, KeyH, KeyE}
+
+	first := typeWithTimer(cadence, keys...)
+	assertDurations(t, typeWithTimer(cadence, keys...), first)
+
+	cadence.Seed = 43
+	other := typeWithTimer(cadence, keys...)
+	same := len(other) == len(first)
+	for i := 0; same && i < len(first); i++ {
+		same = first[i] == other[i]
+	}
+	if same {
+		t.Fatalf("Expected a different seed to produce different timing, got %v twice", first)
+	}
+
+	for _, d := range first {
+		if d < 0 || d > 320*time.Millisecond {
+			t.Fatalf("Duration %v is outside of the configured jitter", d)
+		}
+	}
+}
+
+func TestTypingCadenceBigramVariationIsConstantPerPair(t *testing.T) {
+	timer := newTypingTimer(TypingCadence{BigramVariation: 0.3, Seed: 7})
+	factor := timer.bigramFactor(KeyA, KeyB)
+	if factor < 0.7 || factor > 1.3 {
+		t.Fatalf("Factor %v is outside of the configured variation", factor)
+	}
+	if timer.bigramFactor(KeyA, KeyB) != factor {
+		t.Fatalf("Expected the factor of a key pair to be constant")
+	}
+}
+
+func TestKeyPressUsesTypingCadence(t *testing.T) {
+	vk := createTestKeyboard(t, KeyboardConfig{})
+	defer removeTestDeviceFile(vk.deviceFile)
+	vk.timer = newTypingTimer(TypingCadence{Hold: 20 * time.Millisecond, Delay: 50 * time.Millisecond})
+	clock := &fakeClock{now: time.Unix(0, 0)}
+	clock.install(vk.timer)
+
+	err := vk.TypeString("hi")
+	if err != nil {
+		t.Fatalf("Failed to type string. Last error was: %s\n", err)
+	}
+
+	assertDurations(t, clock.sleeps, []time.Duration{20 * time.Millisecond, 30 * time.Millisecond, 20 * time.Millisecond})
+	expected := []inputEvent{
+		{Type: EvKey, Code: KeyH, Value: btnStatePressed},
+		{Type: EvKey, Code: KeyH, Value: btnStateReleased},
+		{Type: EvKey, Code: KeyI, Value: btnStatePressed},
+		{Type: EvKey, Code: KeyI, Value: btnStateReleased},
+	}
+	assertEvents(t, readTestEvents(t, vk.deviceFile), expected)
+}
@@ -0,0 +1,67 @@
+package uinput
+
+import (
+	"fmt"
+	"os"
+	"time"
+)
+
+// ClickTiming holds the timing of the clicks issued by Click. The defaults stay well inside the
+// double-click thresholds of the common toolkits (400ms and more), so a triple click is recognized
+// as such.
+type ClickTiming struct {
+	// Hold is how long the button is held down on every click. 30ms if zero.
+	Hold time.Duration
+
+	// Interval is the pause between the release of a click and the press of the next one. 80ms if zero.
+	Interval time.Duration
+}
+
+const (
+	defaultClickHold     = 30 * time.Millisecond
+	defaultClickInterval = 80 * time.Millisecond
+)
+
+func (c ClickTiming) withDefaults() ClickTiming {
+	if c.Hold <= 0 {
+		c.Hold = defaultClickHold
+	}
+	if c.Interval <= 0 {
+		c.Interval = defaultClickInterval
+	}
+	return c
+}
+
+// clickButton clicks the button count times. The button is tracked as held down while it is pressed,
+// so ReleaseAll and the watchdog release it if a click is interrupted.
+func clickButton(deviceFile *os.File, state *inputState, button int, count int, timing ClickTiming, sleep func(time.Duration)) error {
+	if count < 1 {
+		return fmt.Errorf("click count must be at least 1, got %d", count)
+	}
+	timing = timing.withDefaults()
+	for i := 0; i < count; i++ {
+		if i > 0 {
+			sleep(timing.Interval)
+		}
+		err := sendTrackedBtnEvent(deviceFile, state, button, btnStatePressed)
+		if err != nil {
+			return fmt.Errorf("failed to issue the click event: %v", err)
+		}
+		sleep(timing.Hold)
+		err = sendTrackedBtnEvent(deviceFile, state, button, btnStateReleased)
+		if err != nil {
+			return fmt.Errorf("failed to issue the click event: %v", err)
+		}
+	}
+	return nil
+}
+
+// assertButton returns an error if the button is not one of the buttons of a device.
+func assertButton(buttons []int, button int) error {
+	for _, b := range buttons {
+		if b == button {
+			return nil
+		}
+	}
+	return fmt.Errorf("the device has no button %#x", button)
+}
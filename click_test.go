@@ -0,0 +1,59 @@
+package uinput
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestDoubleClickHoldsTheButtonAndPausesBetweenClicks(t *testing.T) {
+	file := createTestDeviceFile(t)
+	defer removeTestDeviceFile(file)
+
+	var sleeps []time.Duration
+	state := newInputState()
+	err := clickButton(file, state, BtnLeft, 2, ClickTiming{Interval: 120 * time.Millisecond}, func(d time.Duration) {
+		sleeps = append(sleeps, d)
+	})
+	if err != nil {
+		t.Fatalf("Failed to double click. Last error was: %s\n", err)
+	}
+
+	assertEvents(t, readTestEvents(t, file), []inputEvent{
+		{Type: EvKey, Code: BtnLeft, Value: btnStatePressed},
+		{Type: EvKey, Code: BtnLeft, Value: btnStateReleased},
+		{Type: EvKey, Code: BtnLeft, Value: btnStatePressed},
+		{Type: EvKey, Code: BtnLeft, Value: btnStateReleased},
+	})
+	expected := []time.Duration{defaultClickHold, 120 * time.Millisecond, defaultClickHold}
+	if !reflect.DeepEqual(sleeps, expected) {
+		t.Fatalf("Expected the pauses %v, got %v", expected, sleeps)
+	}
+	if state.isPressed(BtnLeft) {
+		t.Fatalf("Expected the button to be released after the clicks")
+	}
+}
+
+func TestDefaultTripleClickStaysWithinDoubleClickThreshold(t *testing.T) {
+	timing := ClickTiming{}.withDefaults()
+	// from the first to the last press, the threshold of GTK is 400ms
+	if span := 2 * (timing.Hold + timing.Interval); span >= 400*time.Millisecond {
+		t.Fatalf("Expected a triple click to take less than 400ms, takes %v", span)
+	}
+}
+
+func TestClickFailsOnInvalidCountOrButton(t *testing.T) {
+	file := createTestDeviceFile(t)
+	defer removeTestDeviceFile(file)
+
+	if err := clickButton(file, newInputState(), BtnLeft, 0, ClickTiming{}, func(time.Duration) {}); err == nil {
+		t.Fatalf("Expected a click count of 0 to fail")
+	}
+	touchPad := vTouchPad{deviceFile: file, state: newInputState()}
+	if err := touchPad.Click(BtnMiddle, 1); err == nil {
+		t.Fatalf("Expected clicking a button the touchpad doesn't have to fail")
+	}
+	if events := readTestEvents(t, file); len(events) != 0 {
+		t.Fatalf("Expected no events, got %v", events)
+	}
+}
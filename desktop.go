@@ -0,0 +1,190 @@
+package uinput
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// An Output is a monitor of a virtual desktop. The position and the size are in pixels.
+type Output struct {
+	Name          string
+	X, Y          int32
+	Width, Height int32
+}
+
+// A Desktop maps coordinates on a virtual desktop made of outputs to the device units of an
+// absolute pointer like a TouchPad. The range of the device covers the bounding box of the outputs.
+type Desktop struct {
+	outputs []Output
+
+	// device range
+	minX, maxX int32
+	minY, maxY int32
+
+	// bounding box of the outputs
+	left, top     int32
+	width, height int32
+}
+
+// NewDesktop creates a desktop made of the given outputs for a device with the given range, i.e.
+// the minimum and maximum values of the x and y-axis the device was created with.
+func NewDesktop(minX, maxX, minY, maxY int32, outputs ...Output) (*Desktop, error) {
+	if minX >= maxX || minY >= maxY {
+		return nil, fmt.Errorf("invalid device range x %d..%d, y %d..%d", minX, maxX, minY, maxY)
+	}
+	if len(outputs) == 0 {
+		return nil, fmt.Errorf("a desktop needs at least one output")
+	}
+	d := &Desktop{outputs: make([]Output, len(outputs)), minX: minX, maxX: maxX, minY: minY, maxY: maxY}
+	copy(d.outputs, outputs)
+
+	right, bottom := int32(math.MinInt32), int32(math.MinInt32)
+	d.left, d.top = math.MaxInt32, math.MaxInt32
+	for i, o := range d.outputs {
+		if o.Width <= 0 || o.Height <= 0 {
+			return nil, fmt.Errorf("output %q has an invalid size %dx%d", o.Name, o.Width, o.Height)
+		}
+		for _, other := range d.outputs[:i] {
+			if o.Name != "" && o.Name == other.Name {
+				return nil, fmt.Errorf("duplicate output %q", o.Name)
+			}
+		}
+		if o.X < d.left {
+			d.left = o.X
+		}
+		if o.Y < d.top {
+			d.top = o.Y
+		}
+		if o.X+o.Width > right {
+			right = o.X + o.Width
+		}
+		if o.Y+o.Height > bottom {
+			bottom = o.Y + o.Height
+		}
+	}
+	d.width, d.height = right-d.left, bottom-d.top
+	return d, nil
+}
+
+// ParseDesktop creates a desktop for a device with the given range from a layout like
+// "DP-1:2560x1440+0+0, HDMI-1:1920x1080+2560+180". Outputs are separated by commas and given as
+// WIDTHxHEIGHT+X+Y, optionally prefixed with their name and a colon. Offsets may be negative
+// ("1920x1080-1920+0"). Outputs without name are named by their index ("0", "1", ...).
+func ParseDesktop(minX, maxX, minY, maxY int32, layout string) (*Desktop, error) {
+	var outputs []Output
+	for i, s := range strings.Split(layout, ",") {
+		o, err := parseOutput(strings.TrimSpace(s))
+		if err != nil {
+			return nil, err
+		}
+		if o.Name == "" {
+			o.Name = strconv.Itoa(i)
+		}
+		outputs = append(outputs, o)
+	}
+	return NewDesktop(minX, maxX, minY, maxY, outputs...)
+}
+
+func parseOutput(s string) (Output, error) {
+	var o Output
+	geometry := s
+	if i := strings.LastIndex(s, ":"); i >= 0 {
+		o.Name = strings.TrimSpace(s[:i])
+		geometry = strings.TrimSpace(s[i+1:])
+	}
+
+	// WIDTHxHEIGHT followed by two signed offsets
+	x := strings.IndexAny(geometry, "xX")
+	offset := strings.IndexAny(geometry, "+-")
+	if x < 0 || offset < x {
+		return o, fmt.Errorf("invalid output %q: expected WIDTHxHEIGHT+X+Y", s)
+	}
+	second := strings.IndexAny(geometry[offset+1:], "+-")
+	if second < 0 {
+		return o, fmt.Errorf("invalid output %q: expected WIDTHxHEIGHT+X+Y", s)
+	}
+	second += offset + 1
+
+	values := []string{geometry[:x], geometry[x+1 : offset], geometry[offset:second], geometry[second:]}
+	var parsed [4]int32
+	for i, v := range values {
+		n, err := strconv.ParseInt(strings.TrimPrefix(v, "+"), 10, 32)
+		if err != nil {
+			return o, fmt.Errorf("invalid output %q: %v", s, err)
+		}
+		parsed[i] = int32(n)
+	}
+	o.Width, o.Height, o.X, o.Y = parsed[0], parsed[1], parsed[2], parsed[3]
+	return o, nil
+}
+
+// Outputs returns the outputs of the desktop.
+func (d *Desktop) Outputs() []Output {
+	outputs := make([]Output, len(d.outputs))
+	copy(outputs, d.outputs)
+	return outputs
+}
+
+// Normalized converts a position on the whole desktop, where (0, 0) is the upper left and (1, 1)
+// the lower right corner of the bounding box of the outputs, to device units.
+func (d *Desktop) Normalized(x, y float64) (int32, int32, error) {
+	if !isNormalized(x) || !isNormalized(y) {
+		return 0, 0, fmt.Errorf("position (%v, %v) is not within 0..1", x, y)
+	}
+	dx, dy := d.scale(x*float64(d.width), y*float64(d.height))
+	return dx, dy, nil
+}
+
+// OutputNormalized converts a position on the named output, where (0, 0) is its upper left and
+// (1, 1) its lower right corner, to device units.
+func (d *Desktop) OutputNormalized(name string, x, y float64) (int32, int32, error) {
+	o, err := d.output(name)
+	if err != nil {
+		return 0, 0, err
+	}
+	if !isNormalized(x) || !isNormalized(y) {
+		return 0, 0, fmt.Errorf("position (%v, %v) is not within 0..1", x, y)
+	}
+	dx, dy := d.scale(float64(o.X-d.left)+x*float64(o.Width), float64(o.Y-d.top)+y*float64(o.Height))
+	return dx, dy, nil
+}
+
+// Pixel converts the position of a pixel on the named output to the device units of the center
+// of that pixel.
+func (d *Desktop) Pixel(name string, x, y int32) (int32, int32, error) {
+	o, err := d.output(name)
+	if err != nil {
+		return 0, 0, err
+	}
+	if x < 0 || x >= o.Width || y < 0 || y >= o.Height {
+		return 0, 0, fmt.Errorf("pixel (%d, %d) is not on output %q of size %dx%d", x, y, name, o.Width, o.Height)
+	}
+	dx, dy := d.scale(float64(o.X-d.left+x)+0.5, float64(o.Y-d.top+y)+0.5)
+	return dx, dy, nil
+}
+
+func (d *Desktop) output(name string) (Output, error) {
+	for _, o := range d.outputs {
+		if o.Name == name {
+			return o, nil
+		}
+	}
+	return Output{}, fmt.Errorf("unknown output %q", name)
+}
+
+// scale converts a position in pixels relative to the bounding box to device units.
+func (d *Desktop) scale(x, y float64) (int32, int32) {
+	return scaleAxis(x, d.width, d.minX, d.maxX), scaleAxis(y, d.height, d.minY, d.maxY)
+}
+
+// scaleAxis maps 0..size pixels onto min..max.
+func scaleAxis(pixel float64, size int32, min, max int32) int32 {
+	v := float64(min) + pixel*float64(int64(max)-int64(min))/float64(size)
+	return int32(math.Round(v))
+}
+
+func isNormalized(v float64) bool {
+	return v >= 0 && v <= 1
+}
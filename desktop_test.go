@@ -0,0 +1,83 @@
+package uinput
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParseDesktop(t *testing.T) {
+	d, err := ParseDesktop(0, 4480, 0, 1440, "DP-1:2560x1440+0+0, 1920x1080+2560+180")
+	if err != nil {
+		t.Fatalf("Failed to parse the layout. Last error was: %s\n", err)
+	}
+	expected := []Output{
+		{Name: "DP-1", X: 0, Y: 0, Width: 2560, Height: 1440},
+		{Name: "1", X: 2560, Y: 180, Width: 1920, Height: 1080},
+	}
+	if outputs := d.Outputs(); !reflect.DeepEqual(outputs, expected) {
+		t.Fatalf("Expected the outputs %v, got %v", expected, outputs)
+	}
+
+	d, err = ParseDesktop(0, 100, 0, 100, "left:1920x1080-1920+0,1920x1080+0+0")
+	if err != nil {
+		t.Fatalf("Failed to parse the layout. Last error was: %s\n", err)
+	}
+	if o := d.Outputs()[0]; o.X != -1920 || o.Y != 0 {
+		t.Fatalf("Expected the left output at (-1920, 0), got (%d, %d)", o.X, o.Y)
+	}
+
+	for _, layout := range []string{"", "1920x1080", "1920+0+0", "ax1080+0+0", "0x1080+0+0", "a:1x1+0+0,a:1x1+1+0"} {
+		if _, err := ParseDesktop(0, 100, 0, 100, layout); err == nil {
+			t.Errorf("Expected the layout %q to be invalid", layout)
+		}
+	}
+}
+
+func TestDesktopConvertsToDeviceUnits(t *testing.T) {
+	// two outputs side by side, the device range matches the size of the desktop in pixels
+	d, err := ParseDesktop(0, 4480, 0, 1440, "DP-1:2560x1440+0+0, HDMI-1:1920x1080+2560+180")
+	if err != nil {
+		t.Fatalf("Failed to parse the layout. Last error was: %s\n", err)
+	}
+
+	for _, c := range []struct {
+		name      string
+		convert   func() (int32, int32, error)
+		expectedX int32
+		expectedY int32
+	}{
+		{"desktop origin", func() (int32, int32, error) { return d.Normalized(0, 0) }, 0, 0},
+		{"desktop corner", func() (int32, int32, error) { return d.Normalized(1, 1) }, 4480, 1440},
+		{"output center", func() (int32, int32, error) { return d.OutputNormalized("HDMI-1", 0.5, 0.5) }, 3520, 720},
+		{"first pixel", func() (int32, int32, error) { return d.Pixel("HDMI-1", 0, 0) }, 2561, 181},
+		{"last pixel", func() (int32, int32, error) { return d.Pixel("DP-1", 2559, 1439) }, 2560, 1440},
+	} {
+		x, y, err := c.convert()
+		if err != nil {
+			t.Errorf("%s: failed to convert. Last error was: %s", c.name, err)
+			continue
+		}
+		if x != c.expectedX || y != c.expectedY {
+			t.Errorf("%s: expected (%d, %d), got (%d, %d)", c.name, c.expectedX, c.expectedY, x, y)
+		}
+	}
+
+	// a device range that doesn't match the desktop is scaled
+	d, err = NewDesktop(0, 32767, 0, 32767, Output{Name: "a", Width: 1000, Height: 500})
+	if err != nil {
+		t.Fatalf("Failed to create the desktop. Last error was: %s\n", err)
+	}
+	if x, y, _ := d.OutputNormalized("a", 0.25, 1); x != 8192 || y != 32767 {
+		t.Fatalf("Expected (8192, 32767), got (%d, %d)", x, y)
+	}
+
+	if _, _, err := d.Pixel("a", 1000, 0); err == nil {
+		t.Fatalf("Expected a pixel outside of the output to fail")
+	}
+	if _, _, err := d.Normalized(1.5, 0); err == nil {
+		t.Fatalf("Expected a position outside of 0..1 to fail")
+	}
+	if _, _, err := d.OutputNormalized("b", 0, 0); err == nil {
+		t.Fatalf("Expected an unknown output to fail")
+	}
+}
@@ -45,6 +45,10 @@ func (vRel vDial) Turn(delta int32) error {
 	return sendDialEvent(vRel.deviceFile, delta)
 }
 
+func (vRel vDial) pacedFile() *os.File {
+	return vRel.deviceFile
+}
+
 // Close closes the device and releases the device.
 func (vRel vDial) Close() error {
 	return closeDevice(vRel.deviceFile)
@@ -56,14 +60,14 @@ func createDial(path string, name []byte) (fd *os.File, err error) {
 		return nil, fmt.Errorf("could not create dial input device: %v", err)
 	}
 
-	err = registerDevice(deviceFile, uintptr(evRel))
+	err = registerDevice(deviceFile, uintptr(EvRel))
 	if err != nil {
 		deviceFile.Close()
 		return nil, fmt.Errorf("failed to register dial input device: %v", err)
 	}
 
 	// register dial events
-	err = ioctl(deviceFile, uiSetRelBit, uintptr(relDial))
+	err = ioctl(deviceFile, uiSetRelBit, uintptr(RelDial))
 	if err != nil {
 		deviceFile.Close()
 		return nil, fmt.Errorf("failed to register dial events: %v", err)
@@ -73,7 +77,7 @@ func createDial(path string, name []byte) (fd *os.File, err error) {
 		uinputUserDev{
 			Name: toUinputName(name),
 			ID: inputID{
-				Bustype: busUsb,
+				Bustype: BusUsb,
 				Vendor:  0x4711,
 				Product: 0x0816,
 				Version: 1}})
@@ -82,19 +86,13 @@ func createDial(path string, name []byte) (fd *os.File, err error) {
 func sendDialEvent(deviceFile *os.File, delta int32) error {
 	iev := inputEvent{
 		Time:  syscall.Timeval{Sec: 0, Usec: 0},
-		Type:  evRel,
-		Code:  relDial,
+		Type:  EvRel,
+		Code:  RelDial,
 		Value: delta}
 
-	buf, err := inputEventToBuffer(iev)
-	if err != nil {
-		return fmt.Errorf("writing abs event failed: %v", err)
-	}
-
-	_, err = deviceFile.Write(buf)
+	err := sendEvents(deviceFile, []inputEvent{iev})
 	if err != nil {
 		return fmt.Errorf("failed to write rel event to device file: %v", err)
 	}
-
-	return syncEvents(deviceFile)
+	return nil
 }
@@ -0,0 +1,64 @@
+package uinput
+
+import (
+	"fmt"
+	"os"
+	"time"
+)
+
+// DragOptions holds the settings of a drag issued by Drag.
+type DragOptions struct {
+	// Button that is held down during the drag. BtnLeft if zero.
+	Button int
+
+	// Duration of the movement.
+	Duration time.Duration
+
+	// Easing of the movement, EaseLinear if nil.
+	Easing Easing
+
+	// Hold is how long the button is held down before the pointer starts moving, so the press is
+	// recognized before the movement crosses the drag threshold of the toolkit. 100ms if zero.
+	Hold time.Duration
+}
+
+const defaultDragHold = 100 * time.Millisecond
+
+func (o DragOptions) withDefaults() DragOptions {
+	if o.Button == 0 {
+		o.Button = BtnLeft
+	}
+	if o.Hold <= 0 {
+		o.Hold = defaultDragHold
+	}
+	return o
+}
+
+// drag holds the button down while move runs. The button is released even if the movement fails.
+func drag(deviceFile *os.File, state *inputState, options DragOptions, sleep func(time.Duration), move func() error) error {
+	return dragWith(func(btnState int) error {
+		return sendTrackedBtnEvent(deviceFile, state, options.Button, btnState)
+	}, options, sleep, move)
+}
+
+// dragWith is drag for devices that send the button through their own methods: button is called
+// with btnStatePressed before and with btnStateReleased after the movement.
+func dragWith(button func(btnState int) error, options DragOptions, sleep func(time.Duration), move func() error) error {
+	err := button(btnStatePressed)
+	if err != nil {
+		return fmt.Errorf("failed to press the button to drag: %v", err)
+	}
+	sleep(options.Hold)
+	moveErr := move()
+	err = button(btnStateReleased)
+	if moveErr != nil {
+		if err != nil {
+			return fmt.Errorf("failed to drag: %v (and to release the button: %v)", moveErr, err)
+		}
+		return fmt.Errorf("failed to drag: %v", moveErr)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to release the button after the drag: %v", err)
+	}
+	return nil
+}
@@ -0,0 +1,94 @@
+package uinput
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestTouchPadDragMovesWhileTheButtonIsHeld(t *testing.T) {
+	file := createTestDeviceFile(t)
+	defer removeTestDeviceFile(file)
+	touchPad := vTouchPad{deviceFile: file, state: newInputState()}
+
+	err := touchPad.Drag(10, 20, 110, 70, DragOptions{Button: BtnRight, Hold: time.Nanosecond})
+	if err != nil {
+		t.Fatalf("Failed to drag. Last error was: %s\n", err)
+	}
+	assertEvents(t, readTestEvents(t, file), []inputEvent{
+		{Type: EvAbs, Code: AbsX, Value: 10},
+		{Type: EvAbs, Code: AbsY, Value: 20},
+		{Type: EvKey, Code: BtnRight, Value: btnStatePressed},
+		{Type: EvAbs, Code: AbsX, Value: 110},
+		{Type: EvAbs, Code: AbsY, Value: 70},
+		{Type: EvKey, Code: BtnRight, Value: btnStateReleased},
+	})
+}
+
+func TestMouseDragEndsAtTheDestination(t *testing.T) {
+	file := createTestDeviceFile(t)
+	defer removeTestDeviceFile(file)
+	mouse := vMouse{deviceFile: file, state: newInputState()}
+
+	err := mouse.Drag(-40, 25, DragOptions{Duration: 24 * time.Millisecond, Easing: EaseInOut, Hold: time.Nanosecond})
+	if err != nil {
+		t.Fatalf("Failed to drag. Last error was: %s\n", err)
+	}
+	events := readTestEvents(t, file)
+	if events[0].Code != BtnLeft || events[0].Value != btnStatePressed {
+		t.Fatalf("Expected the drag to start with a press of the left button, got %v", events[0])
+	}
+	last := events[len(events)-1]
+	if last.Code != BtnLeft || last.Value != btnStateReleased {
+		t.Fatalf("Expected the drag to end with a release of the left button, got %v", last)
+	}
+	var x, y int32
+	for _, ev := range events[1 : len(events)-1] {
+		if ev.Code == RelX {
+			x += ev.Value
+		} else {
+			y += ev.Value
+		}
+	}
+	if x != -40 || y != 25 {
+		t.Fatalf("Expected a total movement of (-40, 25), got (%d, %d)", x, y)
+	}
+}
+
+func TestDragReleasesTheButtonIfTheMovementFails(t *testing.T) {
+	file := createTestDeviceFile(t)
+	defer removeTestDeviceFile(file)
+	state := newInputState()
+
+	var slept time.Duration
+	options := DragOptions{}.withDefaults()
+	err := drag(file, state, options, func(d time.Duration) { slept += d }, func() error {
+		return errors.New("lost the pointer")
+	})
+	if err == nil {
+		t.Fatalf("Expected the drag to fail")
+	}
+	if slept != defaultDragHold {
+		t.Fatalf("Expected the button to be held for %v before moving, got %v", defaultDragHold, slept)
+	}
+	if state.isPressed(BtnLeft) {
+		t.Fatalf("Expected the button to be released after the failed drag")
+	}
+	assertEvents(t, readTestEvents(t, file), []inputEvent{
+		{Type: EvKey, Code: BtnLeft, Value: btnStatePressed},
+		{Type: EvKey, Code: BtnLeft, Value: btnStateReleased},
+	})
+}
+
+func TestDragFailsOnUnknownButton(t *testing.T) {
+	file := createTestDeviceFile(t)
+	defer removeTestDeviceFile(file)
+	mouse := vMouse{deviceFile: file, state: newInputState()}
+
+	if err := mouse.Drag(1, 1, DragOptions{Button: BtnTask}); err == nil {
+		t.Fatalf("Expected dragging with a button the mouse doesn't have to fail")
+	}
+	if events := readTestEvents(t, file); len(events) != 0 {
+		t.Fatalf("Expected no events, got %v", events)
+	}
+}
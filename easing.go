@@ -0,0 +1,77 @@
+package uinput
+
+import (
+	"math"
+	"math/rand"
+)
+
+// An Easing describes how a movement progresses over time. At returns the fraction of the
+// movement done along the x and y-axis at the time t (both from 0 to 1). At(0) has to be (0, 0)
+// and At(1) has to be (1, 1), values in between may leave that range.
+type Easing interface {
+	At(t float64) (x, y float64)
+}
+
+// EasingFunc turns a function of time into an Easing that moves along a straight line.
+type EasingFunc func(t float64) float64
+
+// At returns f(t) for both axes.
+func (f EasingFunc) At(t float64) (x, y float64) {
+	v := f(t)
+	return v, v
+}
+
+var (
+	// EaseLinear moves at a constant speed.
+	EaseLinear Easing = EasingFunc(func(t float64) float64 { return t })
+
+	// EaseInOut accelerates at the beginning and slows down at the end (cubic).
+	EaseInOut Easing = EasingFunc(easeInOutCubic)
+
+	// EaseOut starts fast and decelerates towards the end (cubic).
+	EaseOut Easing = EasingFunc(func(t float64) float64 { return 1 - math.Pow(1-t, 3) })
+
+	// EaseOutExpo starts fast and decelerates strongly, like kinetic scrolling coming to a halt.
+	EaseOutExpo Easing = EasingFunc(func(t float64) float64 {
+		if t >= 1 {
+			return 1
+		}
+		return 1 - math.Pow(2, -10*t)
+	})
+)
+
+func easeInOutCubic(t float64) float64 {
+	if t < 0.5 {
+		return 4 * t * t * t
+	}
+	return 1 - math.Pow(-2*t+2, 3)/2
+}
+
+// BezierEasing moves along a cubic Bezier curve from the start (0, 0) to the destination (1, 1).
+// The control points are given as fractions of the movement, e.g. (0.2, 0.6) is at 20% of the
+// movement along the x-axis and 60% along the y-axis. The curve is traversed with EaseInOut timing.
+type BezierEasing struct {
+	P1X, P1Y float64
+	P2X, P2Y float64
+}
+
+// At returns the point of the curve at the time t.
+func (b BezierEasing) At(t float64) (x, y float64) {
+	t = easeInOutCubic(t)
+	u := 1 - t
+	// the start point is (0, 0), so its term vanishes
+	x = 3*u*u*t*b.P1X + 3*u*t*t*b.P2X + t*t*t
+	y = 3*u*u*t*b.P1Y + 3*u*t*t*b.P2Y + t*t*t
+	return x, y
+}
+
+// RandomBezierEasing returns a Bezier curve with randomized control points, so repeated movements
+// don't follow the same path. spread is the maximum deviation of the control points from the
+// straight line, as a fraction of the movement (e.g. 0.3).
+func RandomBezierEasing(rng *rand.Rand, spread float64) BezierEasing {
+	offset := func() float64 { return spread * (2*rng.Float64() - 1) }
+	return BezierEasing{
+		P1X: 1.0/3 + offset(), P1Y: 1.0/3 + offset(),
+		P2X: 2.0/3 + offset(), P2Y: 2.0/3 + offset(),
+	}
+}
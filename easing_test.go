@@ -0,0 +1,44 @@
+package uinput
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+)
+
+func TestEasingsStartAndEndAtTheDestination(t *testing.T) {
+	for name, easing := range map[string]Easing{
+		"linear":        EaseLinear,
+		"ease-in-out":   EaseInOut,
+		"bezier":        BezierEasing{P1X: 0.1, P1Y: 0.9, P2X: 0.4, P2Y: 1.2},
+		"random bezier": RandomBezierEasing(rand.New(rand.NewSource(1)), 0.3),
+	} {
+		x, y := easing.At(0)
+		if x != 0 || y != 0 {
+			t.Fatalf("%s: expected At(0) to be (0, 0), got (%v, %v)", name, x, y)
+		}
+		x, y = easing.At(1)
+		if math.Abs(x-1) > 1e-9 || math.Abs(y-1) > 1e-9 {
+			t.Fatalf("%s: expected At(1) to be (1, 1), got (%v, %v)", name, x, y)
+		}
+	}
+}
+
+func TestEaseInOutIsSlowAtTheEnds(t *testing.T) {
+	start, _ := EaseInOut.At(0.1)
+	middle, _ := EaseInOut.At(0.5)
+	if start >= 0.1 || middle != 0.5 {
+		t.Fatalf("Unexpected ease-in-out values: At(0.1) = %v, At(0.5) = %v", start, middle)
+	}
+}
+
+func TestRandomBezierEasingIsReproducible(t *testing.T) {
+	a := RandomBezierEasing(rand.New(rand.NewSource(7)), 0.3)
+	b := RandomBezierEasing(rand.New(rand.NewSource(7)), 0.3)
+	if a != b {
+		t.Fatalf("Expected the same control points for the same seed, got %+v and %+v", a, b)
+	}
+	if math.Abs(a.P1X-1.0/3) > 0.3 || math.Abs(a.P2Y-2.0/3) > 0.3 {
+		t.Fatalf("Control points exceed the spread: %+v", a)
+	}
+}
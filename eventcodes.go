@@ -0,0 +1,885 @@
+// Code generated by go run ./internal/gen; DO NOT EDIT.
+
+package uinput
+
+// The constants in this file relate 1:1 to the definitions in input-event-codes.h and input.h.
+// Names follow the kernel names, e.g. KEY_LEFTCTRL is KeyLeftctrl and ABS_MT_SLOT is AbsMtSlot.
+
+// Device properties and quirks (INPUT_PROP_*).
+const (
+	InputPropPointer       = 0x00 // needs a pointer
+	InputPropDirect        = 0x01 // direct input devices
+	InputPropButtonpad     = 0x02 // has button(s) under pad
+	InputPropSemiMt        = 0x03 // touch rectangle only
+	InputPropTopbuttonpad  = 0x04 // softbuttons at top of pad
+	InputPropPointingStick = 0x05 // is a pointing stick
+	InputPropAccelerometer = 0x06 // has accelerometer
+	InputPropMax           = 0x1f
+	InputPropCnt           = InputPropMax + 1
+)
+
+// Event types (EV_*).
+const (
+	EvSyn      = 0x00
+	EvKey      = 0x01
+	EvRel      = 0x02
+	EvAbs      = 0x03
+	EvMsc      = 0x04
+	EvSw       = 0x05
+	EvLed      = 0x11
+	EvSnd      = 0x12
+	EvRep      = 0x14
+	EvFF       = 0x15
+	EvPwr      = 0x16
+	EvFFStatus = 0x17
+	EvMax      = 0x1f
+	EvCnt      = EvMax + 1
+)
+
+// Synchronization events (SYN_*).
+const (
+	SynReport   = 0x00
+	SynConfig   = 0x01
+	SynMtReport = 0x02
+	SynDropped  = 0x03
+	SynMax      = 0x0f
+	SynCnt      = SynMax + 1
+)
+
+// Keys and buttons (KEY_*, BTN_*).
+const (
+	KeyReserved                = 0x00
+	KeyEsc                     = 0x01
+	Key1                       = 0x02
+	Key2                       = 0x03
+	Key3                       = 0x04
+	Key4                       = 0x05
+	Key5                       = 0x06
+	Key6                       = 0x07
+	Key7                       = 0x08
+	Key8                       = 0x09
+	Key9                       = 0x0a
+	Key0                       = 0x0b
+	KeyMinus                   = 0x0c
+	KeyEqual                   = 0x0d
+	KeyBackspace               = 0x0e
+	KeyTab                     = 0x0f
+	KeyQ                       = 0x10
+	KeyW                       = 0x11
+	KeyE                       = 0x12
+	KeyR                       = 0x13
+	KeyT                       = 0x14
+	KeyY                       = 0x15
+	KeyU                       = 0x16
+	KeyI                       = 0x17
+	KeyO                       = 0x18
+	KeyP                       = 0x19
+	KeyLeftbrace               = 0x1a
+	KeyRightbrace              = 0x1b
+	KeyEnter                   = 0x1c
+	KeyLeftctrl                = 0x1d
+	KeyA                       = 0x1e
+	KeyS                       = 0x1f
+	KeyD                       = 0x20
+	KeyF                       = 0x21
+	KeyG                       = 0x22
+	KeyH                       = 0x23
+	KeyJ                       = 0x24
+	KeyK                       = 0x25
+	KeyL                       = 0x26
+	KeySemicolon               = 0x27
+	KeyApostrophe              = 0x28
+	KeyGrave                   = 0x29
+	KeyLeftshift               = 0x2a
+	KeyBackslash               = 0x2b
+	KeyZ                       = 0x2c
+	KeyX                       = 0x2d
+	KeyC                       = 0x2e
+	KeyV                       = 0x2f
+	KeyB                       = 0x30
+	KeyN                       = 0x31
+	KeyM                       = 0x32
+	KeyComma                   = 0x33
+	KeyDot                     = 0x34
+	KeySlash                   = 0x35
+	KeyRightshift              = 0x36
+	KeyKpasterisk              = 0x37
+	KeyLeftalt                 = 0x38
+	KeySpace                   = 0x39
+	KeyCapslock                = 0x3a
+	KeyF1                      = 0x3b
+	KeyF2                      = 0x3c
+	KeyF3                      = 0x3d
+	KeyF4                      = 0x3e
+	KeyF5                      = 0x3f
+	KeyF6                      = 0x40
+	KeyF7                      = 0x41
+	KeyF8                      = 0x42
+	KeyF9                      = 0x43
+	KeyF10                     = 0x44
+	KeyNumlock                 = 0x45
+	KeyScrolllock              = 0x46
+	KeyKp7                     = 0x47
+	KeyKp8                     = 0x48
+	KeyKp9                     = 0x49
+	KeyKpminus                 = 0x4a
+	KeyKp4                     = 0x4b
+	KeyKp5                     = 0x4c
+	KeyKp6                     = 0x4d
+	KeyKpplus                  = 0x4e
+	KeyKp1                     = 0x4f
+	KeyKp2                     = 0x50
+	KeyKp3                     = 0x51
+	KeyKp0                     = 0x52
+	KeyKpdot                   = 0x53
+	KeyZenkakuhankaku          = 0x55
+	Key102Nd                   = 0x56
+	KeyF11                     = 0x57
+	KeyF12                     = 0x58
+	KeyRo                      = 0x59
+	KeyKatakana                = 0x5a
+	KeyHiragana                = 0x5b
+	KeyHenkan                  = 0x5c
+	KeyKatakanahiragana        = 0x5d
+	KeyMuhenkan                = 0x5e
+	KeyKpjpcomma               = 0x5f
+	KeyKpenter                 = 0x60
+	KeyRightctrl               = 0x61
+	KeyKpslash                 = 0x62
+	KeySysrq                   = 0x63
+	KeyRightalt                = 0x64
+	KeyLinefeed                = 0x65
+	KeyHome                    = 0x66
+	KeyUp                      = 0x67
+	KeyPageup                  = 0x68
+	KeyLeft                    = 0x69
+	KeyRight                   = 0x6a
+	KeyEnd                     = 0x6b
+	KeyDown                    = 0x6c
+	KeyPagedown                = 0x6d
+	KeyInsert                  = 0x6e
+	KeyDelete                  = 0x6f
+	KeyMacro                   = 0x70
+	KeyMute                    = 0x71
+	KeyVolumedown              = 0x72
+	KeyVolumeup                = 0x73
+	KeyPower                   = 0x74 // SC System Power Down
+	KeyKpequal                 = 0x75
+	KeyKpplusminus             = 0x76
+	KeyPause                   = 0x77
+	KeyScale                   = 0x78 // AL Compiz Scale (Expose)
+	KeyKpcomma                 = 0x79
+	KeyHangeul                 = 0x7a
+	KeyHanguel                 = KeyHangeul
+	KeyHanja                   = 0x7b
+	KeyYen                     = 0x7c
+	KeyLeftmeta                = 0x7d
+	KeyRightmeta               = 0x7e
+	KeyCompose                 = 0x7f
+	KeyStop                    = 0x80 // AC Stop
+	KeyAgain                   = 0x81
+	KeyProps                   = 0x82 // AC Properties
+	KeyUndo                    = 0x83 // AC Undo
+	KeyFront                   = 0x84
+	KeyCopy                    = 0x85 // AC Copy
+	KeyOpen                    = 0x86 // AC Open
+	KeyPaste                   = 0x87 // AC Paste
+	KeyFind                    = 0x88 // AC Search
+	KeyCut                     = 0x89 // AC Cut
+	KeyHelp                    = 0x8a // AL Integrated Help Center
+	KeyMenu                    = 0x8b // Menu (show menu)
+	KeyCalc                    = 0x8c // AL Calculator
+	KeySetup                   = 0x8d
+	KeySleep                   = 0x8e // SC System Sleep
+	KeyWakeup                  = 0x8f // System Wake Up
+	KeyFile                    = 0x90 // AL Local Machine Browser
+	KeySendfile                = 0x91
+	KeyDeletefile              = 0x92
+	KeyXfer                    = 0x93
+	KeyProg1                   = 0x94
+	KeyProg2                   = 0x95
+	KeyWww                     = 0x96 // AL Internet Browser
+	KeyMsdos                   = 0x97
+	KeyCoffee                  = 0x98 // AL Terminal Lock/Screensaver
+	KeyScreenlock              = KeyCoffee
+	KeyRotateDisplay           = 0x99 // Display orientation for e.g. tablets
+	KeyDirection               = KeyRotateDisplay
+	KeyCyclewindows            = 0x9a
+	KeyMail                    = 0x9b
+	KeyBookmarks               = 0x9c // AC Bookmarks
+	KeyComputer                = 0x9d
+	KeyBack                    = 0x9e // AC Back
+	KeyForward                 = 0x9f // AC Forward
+	KeyClosecd                 = 0xa0
+	KeyEjectcd                 = 0xa1
+	KeyEjectclosecd            = 0xa2
+	KeyNextsong                = 0xa3
+	KeyPlaypause               = 0xa4
+	KeyPrevioussong            = 0xa5
+	KeyStopcd                  = 0xa6
+	KeyRecord                  = 0xa7
+	KeyRewind                  = 0xa8
+	KeyPhone                   = 0xa9 // Media Select Telephone
+	KeyIso                     = 0xaa
+	KeyConfig                  = 0xab // AL Consumer Control Configuration
+	KeyHomepage                = 0xac // AC Home
+	KeyRefresh                 = 0xad // AC Refresh
+	KeyExit                    = 0xae // AC Exit
+	KeyMove                    = 0xaf
+	KeyEdit                    = 0xb0
+	KeyScrollup                = 0xb1
+	KeyScrolldown              = 0xb2
+	KeyKpleftparen             = 0xb3
+	KeyKprightparen            = 0xb4
+	KeyNew                     = 0xb5 // AC New
+	KeyRedo                    = 0xb6 // AC Redo/Repeat
+	KeyF13                     = 0xb7
+	KeyF14                     = 0xb8
+	KeyF15                     = 0xb9
+	KeyF16                     = 0xba
+	KeyF17                     = 0xbb
+	KeyF18                     = 0xbc
+	KeyF19                     = 0xbd
+	KeyF20                     = 0xbe
+	KeyF21                     = 0xbf
+	KeyF22                     = 0xc0
+	KeyF23                     = 0xc1
+	KeyF24                     = 0xc2
+	KeyPlaycd                  = 0xc8
+	KeyPausecd                 = 0xc9
+	KeyProg3                   = 0xca
+	KeyProg4                   = 0xcb
+	KeyAllApplications         = 0xcc // AC Desktop Show All Applications
+	KeyDashboard               = KeyAllApplications
+	KeySuspend                 = 0xcd
+	KeyClose                   = 0xce // AC Close
+	KeyPlay                    = 0xcf
+	KeyFastforward             = 0xd0
+	KeyBassboost               = 0xd1
+	KeyPrint                   = 0xd2 // AC Print
+	KeyHp                      = 0xd3
+	KeyCamera                  = 0xd4
+	KeySound                   = 0xd5
+	KeyQuestion                = 0xd6
+	KeyEmail                   = 0xd7
+	KeyChat                    = 0xd8
+	KeySearch                  = 0xd9
+	KeyConnect                 = 0xda
+	KeyFinance                 = 0xdb // AL Checkbook/Finance
+	KeySport                   = 0xdc
+	KeyShop                    = 0xdd
+	KeyAlterase                = 0xde
+	KeyCancel                  = 0xdf // AC Cancel
+	KeyBrightnessdown          = 0xe0
+	KeyBrightnessup            = 0xe1
+	KeyMedia                   = 0xe2
+	KeySwitchvideomode         = 0xe3
+	KeyKbdillumtoggle          = 0xe4
+	KeyKbdillumdown            = 0xe5
+	KeyKbdillumup              = 0xe6
+	KeySend                    = 0xe7 // AC Send
+	KeyReply                   = 0xe8 // AC Reply
+	KeyForwardmail             = 0xe9 // AC Forward Msg
+	KeySave                    = 0xea // AC Save
+	KeyDocuments               = 0xeb
+	KeyBattery                 = 0xec
+	KeyBluetooth               = 0xed
+	KeyWlan                    = 0xee
+	KeyUwb                     = 0xef
+	KeyUnknown                 = 0xf0
+	KeyVideoNext               = 0xf1 // drive next video source
+	KeyVideoPrev               = 0xf2 // drive previous video source
+	KeyBrightnessCycle         = 0xf3 // brightness up, after max is min
+	KeyBrightnessAuto          = 0xf4
+	KeyBrightnessZero          = KeyBrightnessAuto
+	KeyDisplayOff              = 0xf5 // display device to off state
+	KeyWwan                    = 0xf6 // Wireless WAN (LTE, UMTS, GSM, etc.)
+	KeyWimax                   = KeyWwan
+	KeyRfkill                  = 0xf7 // Key that controls all radios
+	KeyMicmute                 = 0xf8 // Mute / unmute the microphone
+	BtnMisc                    = 0x100
+	Btn0                       = 0x100
+	Btn1                       = 0x101
+	Btn2                       = 0x102
+	Btn3                       = 0x103
+	Btn4                       = 0x104
+	Btn5                       = 0x105
+	Btn6                       = 0x106
+	Btn7                       = 0x107
+	Btn8                       = 0x108
+	Btn9                       = 0x109
+	BtnMouse                   = 0x110
+	BtnLeft                    = 0x110
+	BtnRight                   = 0x111
+	BtnMiddle                  = 0x112
+	BtnSide                    = 0x113
+	BtnExtra                   = 0x114
+	BtnForward                 = 0x115
+	BtnBack                    = 0x116
+	BtnTask                    = 0x117
+	BtnJoystick                = 0x120
+	BtnTrigger                 = 0x120
+	BtnThumb                   = 0x121
+	BtnThumb2                  = 0x122
+	BtnTop                     = 0x123
+	BtnTop2                    = 0x124
+	BtnPinkie                  = 0x125
+	BtnBase                    = 0x126
+	BtnBase2                   = 0x127
+	BtnBase3                   = 0x128
+	BtnBase4                   = 0x129
+	BtnBase5                   = 0x12a
+	BtnBase6                   = 0x12b
+	BtnDead                    = 0x12f
+	BtnGamepad                 = 0x130
+	BtnSouth                   = 0x130
+	BtnA                       = BtnSouth
+	BtnEast                    = 0x131
+	BtnB                       = BtnEast
+	BtnC                       = 0x132
+	BtnNorth                   = 0x133
+	BtnX                       = BtnNorth
+	BtnWest                    = 0x134
+	BtnY                       = BtnWest
+	BtnZ                       = 0x135
+	BtnTl                      = 0x136
+	BtnTr                      = 0x137
+	BtnTl2                     = 0x138
+	BtnTr2                     = 0x139
+	BtnSelect                  = 0x13a
+	BtnStart                   = 0x13b
+	BtnMode                    = 0x13c
+	BtnThumbl                  = 0x13d
+	BtnThumbr                  = 0x13e
+	BtnDigi                    = 0x140
+	BtnToolPen                 = 0x140
+	BtnToolRubber              = 0x141
+	BtnToolBrush               = 0x142
+	BtnToolPencil              = 0x143
+	BtnToolAirbrush            = 0x144
+	BtnToolFinger              = 0x145
+	BtnToolMouse               = 0x146
+	BtnToolLens                = 0x147
+	BtnToolQuinttap            = 0x148 // Five fingers on trackpad
+	BtnStylus3                 = 0x149
+	BtnTouch                   = 0x14a
+	BtnStylus                  = 0x14b
+	BtnStylus2                 = 0x14c
+	BtnToolDoubletap           = 0x14d
+	BtnToolTripletap           = 0x14e
+	BtnToolQuadtap             = 0x14f // Four fingers on trackpad
+	BtnWheel                   = 0x150
+	BtnGearDown                = 0x150
+	BtnGearUp                  = 0x151
+	KeyOk                      = 0x160
+	KeySelect                  = 0x161
+	KeyGoto                    = 0x162
+	KeyClear                   = 0x163
+	KeyPower2                  = 0x164
+	KeyOption                  = 0x165
+	KeyInfo                    = 0x166 // AL OEM Features/Tips/Tutorial
+	KeyTime                    = 0x167
+	KeyVendor                  = 0x168
+	KeyArchive                 = 0x169
+	KeyProgram                 = 0x16a // Media Select Program Guide
+	KeyChannel                 = 0x16b
+	KeyFavorites               = 0x16c
+	KeyEpg                     = 0x16d
+	KeyPvr                     = 0x16e // Media Select Home
+	KeyMhp                     = 0x16f
+	KeyLanguage                = 0x170
+	KeyTitle                   = 0x171
+	KeySubtitle                = 0x172
+	KeyAngle                   = 0x173
+	KeyFullScreen              = 0x174 // AC View Toggle
+	KeyZoom                    = KeyFullScreen
+	KeyMode                    = 0x175
+	KeyKeyboard                = 0x176
+	KeyAspectRatio             = 0x177 // HUTRR37: Aspect
+	KeyScreen                  = KeyAspectRatio
+	KeyPc                      = 0x178 // Media Select Computer
+	KeyTv                      = 0x179 // Media Select TV
+	KeyTv2                     = 0x17a // Media Select Cable
+	KeyVcr                     = 0x17b // Media Select VCR
+	KeyVcr2                    = 0x17c // VCR Plus
+	KeySat                     = 0x17d // Media Select Satellite
+	KeySat2                    = 0x17e
+	KeyCd                      = 0x17f // Media Select CD
+	KeyTape                    = 0x180 // Media Select Tape
+	KeyRadio                   = 0x181
+	KeyTuner                   = 0x182 // Media Select Tuner
+	KeyPlayer                  = 0x183
+	KeyText                    = 0x184
+	KeyDvd                     = 0x185 // Media Select DVD
+	KeyAux                     = 0x186
+	KeyMp3                     = 0x187
+	KeyAudio                   = 0x188 // AL Audio Browser
+	KeyVideo                   = 0x189 // AL Movie Browser
+	KeyDirectory               = 0x18a
+	KeyList                    = 0x18b
+	KeyMemo                    = 0x18c // Media Select Messages
+	KeyCalendar                = 0x18d
+	KeyRed                     = 0x18e
+	KeyGreen                   = 0x18f
+	KeyYellow                  = 0x190
+	KeyBlue                    = 0x191
+	KeyChannelup               = 0x192 // Channel Increment
+	KeyChanneldown             = 0x193 // Channel Decrement
+	KeyFirst                   = 0x194
+	KeyLast                    = 0x195 // Recall Last
+	KeyAb                      = 0x196
+	KeyNext                    = 0x197
+	KeyRestart                 = 0x198
+	KeySlow                    = 0x199
+	KeyShuffle                 = 0x19a
+	KeyBreak                   = 0x19b
+	KeyPrevious                = 0x19c
+	KeyDigits                  = 0x19d
+	KeyTeen                    = 0x19e
+	KeyTwen                    = 0x19f
+	KeyVideophone              = 0x1a0 // Media Select Video Phone
+	KeyGames                   = 0x1a1 // Media Select Games
+	KeyZoomin                  = 0x1a2 // AC Zoom In
+	KeyZoomout                 = 0x1a3 // AC Zoom Out
+	KeyZoomreset               = 0x1a4 // AC Zoom
+	KeyWordprocessor           = 0x1a5 // AL Word Processor
+	KeyEditor                  = 0x1a6 // AL Text Editor
+	KeySpreadsheet             = 0x1a7 // AL Spreadsheet
+	KeyGraphicseditor          = 0x1a8 // AL Graphics Editor
+	KeyPresentation            = 0x1a9 // AL Presentation App
+	KeyDatabase                = 0x1aa // AL Database App
+	KeyNews                    = 0x1ab // AL Newsreader
+	KeyVoicemail               = 0x1ac // AL Voicemail
+	KeyAddressbook             = 0x1ad // AL Contacts/Address Book
+	KeyMessenger               = 0x1ae // AL Instant Messaging
+	KeyDisplaytoggle           = 0x1af // Turn display (LCD) on and off
+	KeyBrightnessToggle        = KeyDisplaytoggle
+	KeySpellcheck              = 0x1b0 // AL Spell Check
+	KeyLogoff                  = 0x1b1 // AL Logoff
+	KeyDollar                  = 0x1b2
+	KeyEuro                    = 0x1b3
+	KeyFrameback               = 0x1b4 // Consumer - transport controls
+	KeyFrameforward            = 0x1b5
+	KeyContextMenu             = 0x1b6 // GenDesc - system context menu
+	KeyMediaRepeat             = 0x1b7 // Consumer - transport control
+	Key10Channelsup            = 0x1b8 // 10 channels up (10+)
+	Key10Channelsdown          = 0x1b9 // 10 channels down (10-)
+	KeyImages                  = 0x1ba // AL Image Browser
+	KeyNotificationCenter      = 0x1bc // Show/hide the notification center
+	KeyPickupPhone             = 0x1bd // Answer incoming call
+	KeyHangupPhone             = 0x1be // Decline incoming call
+	KeyLinkPhone               = 0x1bf // AL Phone Syncing
+	KeyDelEol                  = 0x1c0
+	KeyDelEos                  = 0x1c1
+	KeyInsLine                 = 0x1c2
+	KeyDelLine                 = 0x1c3
+	KeyFn                      = 0x1d0
+	KeyFnEsc                   = 0x1d1
+	KeyFnF1                    = 0x1d2
+	KeyFnF2                    = 0x1d3
+	KeyFnF3                    = 0x1d4
+	KeyFnF4                    = 0x1d5
+	KeyFnF5                    = 0x1d6
+	KeyFnF6                    = 0x1d7
+	KeyFnF7                    = 0x1d8
+	KeyFnF8                    = 0x1d9
+	KeyFnF9                    = 0x1da
+	KeyFnF10                   = 0x1db
+	KeyFnF11                   = 0x1dc
+	KeyFnF12                   = 0x1dd
+	KeyFn1                     = 0x1de
+	KeyFn2                     = 0x1df
+	KeyFnD                     = 0x1e0
+	KeyFnE                     = 0x1e1
+	KeyFnF                     = 0x1e2
+	KeyFnS                     = 0x1e3
+	KeyFnB                     = 0x1e4
+	KeyFnRightShift            = 0x1e5
+	KeyBrlDot1                 = 0x1f1
+	KeyBrlDot2                 = 0x1f2
+	KeyBrlDot3                 = 0x1f3
+	KeyBrlDot4                 = 0x1f4
+	KeyBrlDot5                 = 0x1f5
+	KeyBrlDot6                 = 0x1f6
+	KeyBrlDot7                 = 0x1f7
+	KeyBrlDot8                 = 0x1f8
+	KeyBrlDot9                 = 0x1f9
+	KeyBrlDot10                = 0x1fa
+	KeyNumeric0                = 0x200 // used by phones, remote controls,
+	KeyNumeric1                = 0x201 // and other keypads
+	KeyNumeric2                = 0x202
+	KeyNumeric3                = 0x203
+	KeyNumeric4                = 0x204
+	KeyNumeric5                = 0x205
+	KeyNumeric6                = 0x206
+	KeyNumeric7                = 0x207
+	KeyNumeric8                = 0x208
+	KeyNumeric9                = 0x209
+	KeyNumericStar             = 0x20a
+	KeyNumericPound            = 0x20b
+	KeyNumericA                = 0x20c // Phone key A - HUT Telephony 0xb9
+	KeyNumericB                = 0x20d
+	KeyNumericC                = 0x20e
+	KeyNumericD                = 0x20f
+	KeyCameraFocus             = 0x210
+	KeyWpsButton               = 0x211 // WiFi Protected Setup key
+	KeyTouchpadToggle          = 0x212 // Request switch touchpad on or off
+	KeyTouchpadOn              = 0x213
+	KeyTouchpadOff             = 0x214
+	KeyCameraZoomin            = 0x215
+	KeyCameraZoomout           = 0x216
+	KeyCameraUp                = 0x217
+	KeyCameraDown              = 0x218
+	KeyCameraLeft              = 0x219
+	KeyCameraRight             = 0x21a
+	KeyAttendantOn             = 0x21b
+	KeyAttendantOff            = 0x21c
+	KeyAttendantToggle         = 0x21d // Attendant call on or off
+	KeyLightsToggle            = 0x21e // Reading light on or off
+	BtnDpadUp                  = 0x220
+	BtnDpadDown                = 0x221
+	BtnDpadLeft                = 0x222
+	BtnDpadRight               = 0x223
+	KeyAlsToggle               = 0x230 // Ambient light sensor
+	KeyRotateLockToggle        = 0x231 // Display rotation lock
+	KeyRefreshRateToggle       = 0x232 // Display refresh rate toggle
+	KeyButtonconfig            = 0x240 // AL Button Configuration
+	KeyTaskmanager             = 0x241 // AL Task/Project Manager
+	KeyJournal                 = 0x242 // AL Log/Journal/Timecard
+	KeyControlpanel            = 0x243 // AL Control Panel
+	KeyAppselect               = 0x244 // AL Select Task/Application
+	KeyScreensaver             = 0x245 // AL Screen Saver
+	KeyVoicecommand            = 0x246 // Listening Voice Command
+	KeyAssistant               = 0x247 // AL Context-aware desktop assistant
+	KeyKbdLayoutNext           = 0x248 // AC Next Keyboard Layout Select
+	KeyEmojiPicker             = 0x249 // Show/hide emoji picker (HUTRR101)
+	KeyDictate                 = 0x24a // Start or Stop Voice Dictation Session (HUTRR99)
+	KeyBrightnessMin           = 0x250 // Set Brightness to Minimum
+	KeyBrightnessMax           = 0x251 // Set Brightness to Maximum
+	KeyKbdinputassistPrev      = 0x260
+	KeyKbdinputassistNext      = 0x261
+	KeyKbdinputassistPrevgroup = 0x262
+	KeyKbdinputassistNextgroup = 0x263
+	KeyKbdinputassistAccept    = 0x264
+	KeyKbdinputassistCancel    = 0x265
+	KeyRightUp                 = 0x266
+	KeyRightDown               = 0x267
+	KeyLeftUp                  = 0x268
+	KeyLeftDown                = 0x269
+	KeyRootMenu                = 0x26a // Show Device's Root Menu
+	KeyMediaTopMenu            = 0x26b
+	KeyNumeric11               = 0x26c
+	KeyNumeric12               = 0x26d
+	KeyAudioDesc               = 0x26e
+	Key3DMode                  = 0x26f
+	KeyNextFavorite            = 0x270
+	KeyStopRecord              = 0x271
+	KeyPauseRecord             = 0x272
+	KeyVod                     = 0x273 // Video on Demand
+	KeyUnmute                  = 0x274
+	KeyFastreverse             = 0x275
+	KeySlowreverse             = 0x276
+	KeyData                    = 0x277
+	KeyOnscreenKeyboard        = 0x278
+	KeyPrivacyScreenToggle     = 0x279
+	KeySelectiveScreenshot     = 0x27a
+	KeyNextElement             = 0x27b
+	KeyPreviousElement         = 0x27c
+	KeyAutopilotEngageToggle   = 0x27d
+	KeyMarkWaypoint            = 0x27e
+	KeySos                     = 0x27f
+	KeyNavChart                = 0x280
+	KeyFishingChart            = 0x281
+	KeySingleRangeRadar        = 0x282
+	KeyDualRangeRadar          = 0x283
+	KeyRadarOverlay            = 0x284
+	KeyTraditionalSonar        = 0x285
+	KeyClearvuSonar            = 0x286
+	KeySidevuSonar             = 0x287
+	KeyNavInfo                 = 0x288
+	KeyBrightnessMenu          = 0x289
+	KeyMacro1                  = 0x290
+	KeyMacro2                  = 0x291
+	KeyMacro3                  = 0x292
+	KeyMacro4                  = 0x293
+	KeyMacro5                  = 0x294
+	KeyMacro6                  = 0x295
+	KeyMacro7                  = 0x296
+	KeyMacro8                  = 0x297
+	KeyMacro9                  = 0x298
+	KeyMacro10                 = 0x299
+	KeyMacro11                 = 0x29a
+	KeyMacro12                 = 0x29b
+	KeyMacro13                 = 0x29c
+	KeyMacro14                 = 0x29d
+	KeyMacro15                 = 0x29e
+	KeyMacro16                 = 0x29f
+	KeyMacro17                 = 0x2a0
+	KeyMacro18                 = 0x2a1
+	KeyMacro19                 = 0x2a2
+	KeyMacro20                 = 0x2a3
+	KeyMacro21                 = 0x2a4
+	KeyMacro22                 = 0x2a5
+	KeyMacro23                 = 0x2a6
+	KeyMacro24                 = 0x2a7
+	KeyMacro25                 = 0x2a8
+	KeyMacro26                 = 0x2a9
+	KeyMacro27                 = 0x2aa
+	KeyMacro28                 = 0x2ab
+	KeyMacro29                 = 0x2ac
+	KeyMacro30                 = 0x2ad
+	KeyMacroRecordStart        = 0x2b0
+	KeyMacroRecordStop         = 0x2b1
+	KeyMacroPresetCycle        = 0x2b2
+	KeyMacroPreset1            = 0x2b3
+	KeyMacroPreset2            = 0x2b4
+	KeyMacroPreset3            = 0x2b5
+	KeyKbdLcdMenu1             = 0x2b8
+	KeyKbdLcdMenu2             = 0x2b9
+	KeyKbdLcdMenu3             = 0x2ba
+	KeyKbdLcdMenu4             = 0x2bb
+	KeyKbdLcdMenu5             = 0x2bc
+	BtnTriggerHappy            = 0x2c0
+	BtnTriggerHappy1           = 0x2c0
+	BtnTriggerHappy2           = 0x2c1
+	BtnTriggerHappy3           = 0x2c2
+	BtnTriggerHappy4           = 0x2c3
+	BtnTriggerHappy5           = 0x2c4
+	BtnTriggerHappy6           = 0x2c5
+	BtnTriggerHappy7           = 0x2c6
+	BtnTriggerHappy8           = 0x2c7
+	BtnTriggerHappy9           = 0x2c8
+	BtnTriggerHappy10          = 0x2c9
+	BtnTriggerHappy11          = 0x2ca
+	BtnTriggerHappy12          = 0x2cb
+	BtnTriggerHappy13          = 0x2cc
+	BtnTriggerHappy14          = 0x2cd
+	BtnTriggerHappy15          = 0x2ce
+	BtnTriggerHappy16          = 0x2cf
+	BtnTriggerHappy17          = 0x2d0
+	BtnTriggerHappy18          = 0x2d1
+	BtnTriggerHappy19          = 0x2d2
+	BtnTriggerHappy20          = 0x2d3
+	BtnTriggerHappy21          = 0x2d4
+	BtnTriggerHappy22          = 0x2d5
+	BtnTriggerHappy23          = 0x2d6
+	BtnTriggerHappy24          = 0x2d7
+	BtnTriggerHappy25          = 0x2d8
+	BtnTriggerHappy26          = 0x2d9
+	BtnTriggerHappy27          = 0x2da
+	BtnTriggerHappy28          = 0x2db
+	BtnTriggerHappy29          = 0x2dc
+	BtnTriggerHappy30          = 0x2dd
+	BtnTriggerHappy31          = 0x2de
+	BtnTriggerHappy32          = 0x2df
+	BtnTriggerHappy33          = 0x2e0
+	BtnTriggerHappy34          = 0x2e1
+	BtnTriggerHappy35          = 0x2e2
+	BtnTriggerHappy36          = 0x2e3
+	BtnTriggerHappy37          = 0x2e4
+	BtnTriggerHappy38          = 0x2e5
+	BtnTriggerHappy39          = 0x2e6
+	BtnTriggerHappy40          = 0x2e7
+	KeyMinInteresting          = KeyMute
+	KeyMax                     = 0x2ff
+	KeyCnt                     = KeyMax + 1
+)
+
+// Relative axes (REL_*).
+const (
+	RelX           = 0x00
+	RelY           = 0x01
+	RelZ           = 0x02
+	RelRx          = 0x03
+	RelRy          = 0x04
+	RelRz          = 0x05
+	RelHwheel      = 0x06
+	RelDial        = 0x07
+	RelWheel       = 0x08
+	RelMisc        = 0x09
+	RelReserved    = 0x0a
+	RelWheelHiRes  = 0x0b
+	RelHwheelHiRes = 0x0c
+	RelMax         = 0x0f
+	RelCnt         = RelMax + 1
+)
+
+// Absolute axes (ABS_*).
+const (
+	AbsX             = 0x00
+	AbsY             = 0x01
+	AbsZ             = 0x02
+	AbsRx            = 0x03
+	AbsRy            = 0x04
+	AbsRz            = 0x05
+	AbsThrottle      = 0x06
+	AbsRudder        = 0x07
+	AbsWheel         = 0x08
+	AbsGas           = 0x09
+	AbsBrake         = 0x0a
+	AbsHat0X         = 0x10
+	AbsHat0Y         = 0x11
+	AbsHat1X         = 0x12
+	AbsHat1Y         = 0x13
+	AbsHat2X         = 0x14
+	AbsHat2Y         = 0x15
+	AbsHat3X         = 0x16
+	AbsHat3Y         = 0x17
+	AbsPressure      = 0x18
+	AbsDistance      = 0x19
+	AbsTiltX         = 0x1a
+	AbsTiltY         = 0x1b
+	AbsToolWidth     = 0x1c
+	AbsVolume        = 0x20
+	AbsProfile       = 0x21
+	AbsMisc          = 0x28
+	AbsReserved      = 0x2e
+	AbsMtSlot        = 0x2f // MT slot being modified
+	AbsMtTouchMajor  = 0x30 // Major axis of touching ellipse
+	AbsMtTouchMinor  = 0x31 // Minor axis (omit if circular)
+	AbsMtWidthMajor  = 0x32 // Major axis of approaching ellipse
+	AbsMtWidthMinor  = 0x33 // Minor axis (omit if circular)
+	AbsMtOrientation = 0x34 // Ellipse orientation
+	AbsMtPositionX   = 0x35 // Center X touch position
+	AbsMtPositionY   = 0x36 // Center Y touch position
+	AbsMtToolType    = 0x37 // Type of touching device
+	AbsMtBlobId      = 0x38 // Group a set of packets as a blob
+	AbsMtTrackingId  = 0x39 // Unique ID of initiated contact
+	AbsMtPressure    = 0x3a // Pressure on contact area
+	AbsMtDistance    = 0x3b // Contact hover distance
+	AbsMtToolX       = 0x3c // Center X tool position
+	AbsMtToolY       = 0x3d // Center Y tool position
+	AbsMax           = 0x3f
+	AbsCnt           = AbsMax + 1
+)
+
+// Switch events (SW_*).
+const (
+	SwLid                = 0x00 // set = lid shut
+	SwTabletMode         = 0x01 // set = tablet mode
+	SwHeadphoneInsert    = 0x02 // set = inserted
+	SwRfkillAll          = 0x03
+	SwRadio              = SwRfkillAll // deprecated
+	SwMicrophoneInsert   = 0x04        // set = inserted
+	SwDock               = 0x05        // set = plugged into dock
+	SwLineoutInsert      = 0x06        // set = inserted
+	SwJackPhysicalInsert = 0x07        // set = mechanical switch set
+	SwVideooutInsert     = 0x08        // set = inserted
+	SwCameraLensCover    = 0x09        // set = lens covered
+	SwKeypadSlide        = 0x0a        // set = keypad slide out
+	SwFrontProximity     = 0x0b        // set = front proximity sensor active
+	SwRotateLock         = 0x0c        // set = rotate locked/disabled
+	SwLineinInsert       = 0x0d        // set = inserted
+	SwMuteDevice         = 0x0e        // set = device disabled
+	SwPenInserted        = 0x0f        // set = pen inserted
+	SwMachineCover       = 0x10        // set = cover closed
+	SwMax                = 0x10
+	SwCnt                = SwMax + 1
+)
+
+// Misc events (MSC_*).
+const (
+	MscSerial    = 0x00
+	MscPulseled  = 0x01
+	MscGesture   = 0x02
+	MscRaw       = 0x03
+	MscScan      = 0x04
+	MscTimestamp = 0x05
+	MscMax       = 0x07
+	MscCnt       = MscMax + 1
+)
+
+// LEDs (LED_*).
+const (
+	LedNuml     = 0x00
+	LedCapsl    = 0x01
+	LedScrolll  = 0x02
+	LedCompose  = 0x03
+	LedKana     = 0x04
+	LedSleep    = 0x05
+	LedSuspend  = 0x06
+	LedMute     = 0x07
+	LedMisc     = 0x08
+	LedMail     = 0x09
+	LedCharging = 0x0a
+	LedMax      = 0x0f
+	LedCnt      = LedMax + 1
+)
+
+// Autorepeat values (REP_*).
+const (
+	RepDelay  = 0x00
+	RepPeriod = 0x01
+	RepMax    = 0x01
+	RepCnt    = RepMax + 1
+)
+
+// Sounds (SND_*).
+const (
+	SndClick = 0x00
+	SndBell  = 0x01
+	SndTone  = 0x02
+	SndMax   = 0x07
+	SndCnt   = SndMax + 1
+)
+
+// Force-feedback status values (FF_STATUS_*).
+const (
+	FFStatusStopped = 0x00
+	FFStatusPlaying = 0x01
+	FFStatusMax     = 0x01
+)
+
+// Force-feedback effect types, waveforms and properties (FF_*).
+const (
+	FFRumble      = 0x50
+	FFPeriodic    = 0x51
+	FFConstant    = 0x52
+	FFSpring      = 0x53
+	FFFriction    = 0x54
+	FFDamper      = 0x55
+	FFInertia     = 0x56
+	FFRamp        = 0x57
+	FFEffectMin   = FFRumble
+	FFEffectMax   = FFRamp
+	FFSquare      = 0x58
+	FFTriangle    = 0x59
+	FFSine        = 0x5a
+	FFSawUp       = 0x5b
+	FFSawDown     = 0x5c
+	FFCustom      = 0x5d
+	FFWaveformMin = FFSquare
+	FFWaveformMax = FFCustom
+	FFGain        = 0x60
+	FFAutocenter  = 0x61
+	FFMaxEffects  = FFGain
+	FFMax         = 0x7f
+	FFCnt         = FFMax + 1
+)
+
+// Bus types (BUS_*).
+const (
+	BusPci        = 0x01
+	BusIsapnp     = 0x02
+	BusUsb        = 0x03
+	BusHil        = 0x04
+	BusBluetooth  = 0x05
+	BusVirtual    = 0x06
+	BusIsa        = 0x10
+	BusI8042      = 0x11
+	BusXtkbd      = 0x12
+	BusRs232      = 0x13
+	BusGameport   = 0x14
+	BusParport    = 0x15
+	BusAmiga      = 0x16
+	BusAdb        = 0x17
+	BusI2C        = 0x18
+	BusHost       = 0x19
+	BusGsc        = 0x1a
+	BusAtari      = 0x1b
+	BusSpi        = 0x1c
+	BusRmi        = 0x1d
+	BusCec        = 0x1e
+	BusIntelIshtp = 0x1f
+	BusAmdSfh     = 0x20
+)
@@ -0,0 +1,140 @@
+package uinput
+
+//go:generate go run ./internal/gen
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// EventType is the type of an input event (EV_KEY, EV_REL, EV_ABS, ...).
+type EventType uint16
+
+// String returns the name of the event type as defined in input-event-codes.h, e.g. "EV_KEY".
+func (t EventType) String() string {
+	if name, ok := eventTypeNames[t]; ok {
+		return name
+	}
+	return fmt.Sprintf("EV_%#02x", uint16(t))
+}
+
+// EventCode is a code together with the event type it belongs to, e.g. {EV_KEY, 30} for KEY_A.
+type EventCode struct {
+	Type EventType
+	Code uint16
+}
+
+// String returns the name of the code as defined in input-event-codes.h, e.g. "KEY_A" or "BTN_SOUTH".
+// Codes without a name are printed as their event type followed by the numeric code.
+func (c EventCode) String() string {
+	if name, ok := eventCodeNames[c.Type][c.Code]; ok {
+		return name
+	}
+	return fmt.Sprintf("%v:%#02x", c.Type, c.Code)
+}
+
+// goNameAliases holds the Go constant names of this package that do not follow
+// the naming scheme of the kernel names (KEY_LEFTCTRL -> KeyLeftctrl).
+var goNameAliases = map[string]string{
+	"ButtonGamepad":      "BTN_GAMEPAD",
+	"ButtonSouth":        "BTN_SOUTH",
+	"ButtonEast":         "BTN_EAST",
+	"ButtonNorth":        "BTN_NORTH",
+	"ButtonWest":         "BTN_WEST",
+	"ButtonBumperLeft":   "BTN_TL",
+	"ButtonBumperRight":  "BTN_TR",
+	"ButtonTriggerLeft":  "BTN_TL2",
+	"ButtonTriggerRight": "BTN_TR2",
+	"ButtonThumbLeft":    "BTN_THUMBL",
+	"ButtonThumbRight":   "BTN_THUMBR",
+	"ButtonSelect":       "BTN_SELECT",
+	"ButtonStart":        "BTN_START",
+	"ButtonDpadUp":       "BTN_DPAD_UP",
+	"ButtonDpadDown":     "BTN_DPAD_DOWN",
+	"ButtonDpadLeft":     "BTN_DPAD_LEFT",
+	"ButtonDpadRight":    "BTN_DPAD_RIGHT",
+	"ButtonMode":         "BTN_MODE",
+}
+
+// eventCodesByFoldedName maps the folded form (see foldName) of every kernel name and
+// Go constant name to its code.
+var eventCodesByFoldedName map[string]EventCode
+
+// eventTypesByFoldedName does the same for event types.
+var eventTypesByFoldedName map[string]EventType
+
+func init() {
+	eventCodesByFoldedName = make(map[string]EventCode, len(eventCodesByName)+len(goNameAliases))
+	for name, code := range eventCodesByName {
+		eventCodesByFoldedName[foldName(name)] = code
+	}
+	for goName, name := range goNameAliases {
+		eventCodesByFoldedName[foldName(goName)] = eventCodesByName[name]
+	}
+
+	eventTypesByFoldedName = make(map[string]EventType, len(eventTypeNames))
+	for t, name := range eventTypeNames {
+		eventTypesByFoldedName[foldName(name)] = t
+	}
+}
+
+// foldName brings kernel names (KEY_LEFTCTRL) and Go constant names (KeyLeftctrl) into the
+// same form by dropping underscores and ignoring case.
+func foldName(name string) string {
+	return strings.ToUpper(strings.Replace(name, "_", "", -1))
+}
+
+// ParseEventType parses the name of an event type. Both the kernel name ("EV_KEY")
+// and the Go constant name ("EvKey") are accepted.
+func ParseEventType(name string) (EventType, error) {
+	if t, ok := eventTypesByFoldedName[foldName(name)]; ok {
+		return t, nil
+	}
+	return 0, fmt.Errorf("unknown event type %q", name)
+}
+
+// ParseEventCode parses the name of an event code. Kernel names ("KEY_A", "BTN_A", "ABS_X")
+// as well as Go constant names ("KeyA", "ButtonSouth", "AbsX") are accepted. The event type
+// is derived from the name.
+func ParseEventCode(name string) (EventCode, error) {
+	if c, ok := eventCodesByName[name]; ok {
+		return c, nil
+	}
+	if c, ok := eventCodesByFoldedName[foldName(name)]; ok {
+		return c, nil
+	}
+	return EventCode{}, fmt.Errorf("unknown event code %q", name)
+}
+
+// ParseCode parses the name of a code that has to belong to the given event type.
+// In addition to the names accepted by ParseEventCode, plain decimal or hexadecimal
+// numbers ("30", "0x1e") are accepted, which is handy for codes without a name.
+func ParseCode(t EventType, name string) (uint16, error) {
+	if v, err := strconv.ParseUint(name, 0, 16); err == nil {
+		return uint16(v), nil
+	}
+	c, err := ParseEventCode(name)
+	if err != nil {
+		return 0, err
+	}
+	if c.Type != t {
+		return 0, fmt.Errorf("%s is a %v code, expected a %v code", name, c.Type, t)
+	}
+	return c.Code, nil
+}
+
+// ParseKey parses the name of a key or button (see ParseCode) and returns it in the form
+// used by the Keyboard and Gamepad functions.
+func ParseKey(name string) (int, error) {
+	code, err := ParseCode(EvKey, name)
+	if err != nil {
+		return 0, err
+	}
+	return int(code), nil
+}
+
+// KeyName returns the kernel name of a key or button code, e.g. "KEY_A" for KeyA.
+func KeyName(key int) string {
+	return EventCode{Type: EvKey, Code: uint16(key)}.String()
+}
@@ -0,0 +1,1534 @@
+// Code generated by go run ./internal/gen; DO NOT EDIT.
+
+package uinput
+
+// eventTypeNames maps every event type to its name in input-event-codes.h.
+var eventTypeNames = map[EventType]string{
+	EvSyn:      "EV_SYN",
+	EvKey:      "EV_KEY",
+	EvRel:      "EV_REL",
+	EvAbs:      "EV_ABS",
+	EvMsc:      "EV_MSC",
+	EvSw:       "EV_SW",
+	EvLed:      "EV_LED",
+	EvSnd:      "EV_SND",
+	EvRep:      "EV_REP",
+	EvFF:       "EV_FF",
+	EvPwr:      "EV_PWR",
+	EvFFStatus: "EV_FF_STATUS",
+}
+
+// eventCodeNames maps the codes of every event type to the name used to display them.
+var eventCodeNames = map[EventType]map[uint16]string{
+	EvSyn: {
+		SynReport:   "SYN_REPORT",
+		SynConfig:   "SYN_CONFIG",
+		SynMtReport: "SYN_MT_REPORT",
+		SynDropped:  "SYN_DROPPED",
+	},
+	EvKey: {
+		KeyReserved:                "KEY_RESERVED",
+		KeyEsc:                     "KEY_ESC",
+		Key1:                       "KEY_1",
+		Key2:                       "KEY_2",
+		Key3:                       "KEY_3",
+		Key4:                       "KEY_4",
+		Key5:                       "KEY_5",
+		Key6:                       "KEY_6",
+		Key7:                       "KEY_7",
+		Key8:                       "KEY_8",
+		Key9:                       "KEY_9",
+		Key0:                       "KEY_0",
+		KeyMinus:                   "KEY_MINUS",
+		KeyEqual:                   "KEY_EQUAL",
+		KeyBackspace:               "KEY_BACKSPACE",
+		KeyTab:                     "KEY_TAB",
+		KeyQ:                       "KEY_Q",
+		KeyW:                       "KEY_W",
+		KeyE:                       "KEY_E",
+		KeyR:                       "KEY_R",
+		KeyT:                       "KEY_T",
+		KeyY:                       "KEY_Y",
+		KeyU:                       "KEY_U",
+		KeyI:                       "KEY_I",
+		KeyO:                       "KEY_O",
+		KeyP:                       "KEY_P",
+		KeyLeftbrace:               "KEY_LEFTBRACE",
+		KeyRightbrace:              "KEY_RIGHTBRACE",
+		KeyEnter:                   "KEY_ENTER",
+		KeyLeftctrl:                "KEY_LEFTCTRL",
+		KeyA:                       "KEY_A",
+		KeyS:                       "KEY_S",
+		KeyD:                       "KEY_D",
+		KeyF:                       "KEY_F",
+		KeyG:                       "KEY_G",
+		KeyH:                       "KEY_H",
+		KeyJ:                       "KEY_J",
+		KeyK:                       "KEY_K",
+		KeyL:                       "KEY_L",
+		KeySemicolon:               "KEY_SEMICOLON",
+		KeyApostrophe:              "KEY_APOSTROPHE",
+		KeyGrave:                   "KEY_GRAVE",
+		KeyLeftshift:               "KEY_LEFTSHIFT",
+		KeyBackslash:               "KEY_BACKSLASH",
+		KeyZ:                       "KEY_Z",
+		KeyX:                       "KEY_X",
+		KeyC:                       "KEY_C",
+		KeyV:                       "KEY_V",
+		KeyB:                       "KEY_B",
+		KeyN:                       "KEY_N",
+		KeyM:                       "KEY_M",
+		KeyComma:                   "KEY_COMMA",
+		KeyDot:                     "KEY_DOT",
+		KeySlash:                   "KEY_SLASH",
+		KeyRightshift:              "KEY_RIGHTSHIFT",
+		KeyKpasterisk:              "KEY_KPASTERISK",
+		KeyLeftalt:                 "KEY_LEFTALT",
+		KeySpace:                   "KEY_SPACE",
+		KeyCapslock:                "KEY_CAPSLOCK",
+		KeyF1:                      "KEY_F1",
+		KeyF2:                      "KEY_F2",
+		KeyF3:                      "KEY_F3",
+		KeyF4:                      "KEY_F4",
+		KeyF5:                      "KEY_F5",
+		KeyF6:                      "KEY_F6",
+		KeyF7:                      "KEY_F7",
+		KeyF8:                      "KEY_F8",
+		KeyF9:                      "KEY_F9",
+		KeyF10:                     "KEY_F10",
+		KeyNumlock:                 "KEY_NUMLOCK",
+		KeyScrolllock:              "KEY_SCROLLLOCK",
+		KeyKp7:                     "KEY_KP7",
+		KeyKp8:                     "KEY_KP8",
+		KeyKp9:                     "KEY_KP9",
+		KeyKpminus:                 "KEY_KPMINUS",
+		KeyKp4:                     "KEY_KP4",
+		KeyKp5:                     "KEY_KP5",
+		KeyKp6:                     "KEY_KP6",
+		KeyKpplus:                  "KEY_KPPLUS",
+		KeyKp1:                     "KEY_KP1",
+		KeyKp2:                     "KEY_KP2",
+		KeyKp3:                     "KEY_KP3",
+		KeyKp0:                     "KEY_KP0",
+		KeyKpdot:                   "KEY_KPDOT",
+		KeyZenkakuhankaku:          "KEY_ZENKAKUHANKAKU",
+		Key102Nd:                   "KEY_102ND",
+		KeyF11:                     "KEY_F11",
+		KeyF12:                     "KEY_F12",
+		KeyRo:                      "KEY_RO",
+		KeyKatakana:                "KEY_KATAKANA",
+		KeyHiragana:                "KEY_HIRAGANA",
+		KeyHenkan:                  "KEY_HENKAN",
+		KeyKatakanahiragana:        "KEY_KATAKANAHIRAGANA",
+		KeyMuhenkan:                "KEY_MUHENKAN",
+		KeyKpjpcomma:               "KEY_KPJPCOMMA",
+		KeyKpenter:                 "KEY_KPENTER",
+		KeyRightctrl:               "KEY_RIGHTCTRL",
+		KeyKpslash:                 "KEY_KPSLASH",
+		KeySysrq:                   "KEY_SYSRQ",
+		KeyRightalt:                "KEY_RIGHTALT",
+		KeyLinefeed:                "KEY_LINEFEED",
+		KeyHome:                    "KEY_HOME",
+		KeyUp:                      "KEY_UP",
+		KeyPageup:                  "KEY_PAGEUP",
+		KeyLeft:                    "KEY_LEFT",
+		KeyRight:                   "KEY_RIGHT",
+		KeyEnd:                     "KEY_END",
+		KeyDown:                    "KEY_DOWN",
+		KeyPagedown:                "KEY_PAGEDOWN",
+		KeyInsert:                  "KEY_INSERT",
+		KeyDelete:                  "KEY_DELETE",
+		KeyMacro:                   "KEY_MACRO",
+		KeyMute:                    "KEY_MUTE",
+		KeyVolumedown:              "KEY_VOLUMEDOWN",
+		KeyVolumeup:                "KEY_VOLUMEUP",
+		KeyPower:                   "KEY_POWER",
+		KeyKpequal:                 "KEY_KPEQUAL",
+		KeyKpplusminus:             "KEY_KPPLUSMINUS",
+		KeyPause:                   "KEY_PAUSE",
+		KeyScale:                   "KEY_SCALE",
+		KeyKpcomma:                 "KEY_KPCOMMA",
+		KeyHangeul:                 "KEY_HANGEUL",
+		KeyHanja:                   "KEY_HANJA",
+		KeyYen:                     "KEY_YEN",
+		KeyLeftmeta:                "KEY_LEFTMETA",
+		KeyRightmeta:               "KEY_RIGHTMETA",
+		KeyCompose:                 "KEY_COMPOSE",
+		KeyStop:                    "KEY_STOP",
+		KeyAgain:                   "KEY_AGAIN",
+		KeyProps:                   "KEY_PROPS",
+		KeyUndo:                    "KEY_UNDO",
+		KeyFront:                   "KEY_FRONT",
+		KeyCopy:                    "KEY_COPY",
+		KeyOpen:                    "KEY_OPEN",
+		KeyPaste:                   "KEY_PASTE",
+		KeyFind:                    "KEY_FIND",
+		KeyCut:                     "KEY_CUT",
+		KeyHelp:                    "KEY_HELP",
+		KeyMenu:                    "KEY_MENU",
+		KeyCalc:                    "KEY_CALC",
+		KeySetup:                   "KEY_SETUP",
+		KeySleep:                   "KEY_SLEEP",
+		KeyWakeup:                  "KEY_WAKEUP",
+		KeyFile:                    "KEY_FILE",
+		KeySendfile:                "KEY_SENDFILE",
+		KeyDeletefile:              "KEY_DELETEFILE",
+		KeyXfer:                    "KEY_XFER",
+		KeyProg1:                   "KEY_PROG1",
+		KeyProg2:                   "KEY_PROG2",
+		KeyWww:                     "KEY_WWW",
+		KeyMsdos:                   "KEY_MSDOS",
+		KeyCoffee:                  "KEY_COFFEE",
+		KeyRotateDisplay:           "KEY_ROTATE_DISPLAY",
+		KeyCyclewindows:            "KEY_CYCLEWINDOWS",
+		KeyMail:                    "KEY_MAIL",
+		KeyBookmarks:               "KEY_BOOKMARKS",
+		KeyComputer:                "KEY_COMPUTER",
+		KeyBack:                    "KEY_BACK",
+		KeyForward:                 "KEY_FORWARD",
+		KeyClosecd:                 "KEY_CLOSECD",
+		KeyEjectcd:                 "KEY_EJECTCD",
+		KeyEjectclosecd:            "KEY_EJECTCLOSECD",
+		KeyNextsong:                "KEY_NEXTSONG",
+		KeyPlaypause:               "KEY_PLAYPAUSE",
+		KeyPrevioussong:            "KEY_PREVIOUSSONG",
+		KeyStopcd:                  "KEY_STOPCD",
+		KeyRecord:                  "KEY_RECORD",
+		KeyRewind:                  "KEY_REWIND",
+		KeyPhone:                   "KEY_PHONE",
+		KeyIso:                     "KEY_ISO",
+		KeyConfig:                  "KEY_CONFIG",
+		KeyHomepage:                "KEY_HOMEPAGE",
+		KeyRefresh:                 "KEY_REFRESH",
+		KeyExit:                    "KEY_EXIT",
+		KeyMove:                    "KEY_MOVE",
+		KeyEdit:                    "KEY_EDIT",
+		KeyScrollup:                "KEY_SCROLLUP",
+		KeyScrolldown:              "KEY_SCROLLDOWN",
+		KeyKpleftparen:             "KEY_KPLEFTPAREN",
+		KeyKprightparen:            "KEY_KPRIGHTPAREN",
+		KeyNew:                     "KEY_NEW",
+		KeyRedo:                    "KEY_REDO",
+		KeyF13:                     "KEY_F13",
+		KeyF14:                     "KEY_F14",
+		KeyF15:                     "KEY_F15",
+		KeyF16:                     "KEY_F16",
+		KeyF17:                     "KEY_F17",
+		KeyF18:                     "KEY_F18",
+		KeyF19:                     "KEY_F19",
+		KeyF20:                     "KEY_F20",
+		KeyF21:                     "KEY_F21",
+		KeyF22:                     "KEY_F22",
+		KeyF23:                     "KEY_F23",
+		KeyF24:                     "KEY_F24",
+		KeyPlaycd:                  "KEY_PLAYCD",
+		KeyPausecd:                 "KEY_PAUSECD",
+		KeyProg3:                   "KEY_PROG3",
+		KeyProg4:                   "KEY_PROG4",
+		KeyAllApplications:         "KEY_ALL_APPLICATIONS",
+		KeySuspend:                 "KEY_SUSPEND",
+		KeyClose:                   "KEY_CLOSE",
+		KeyPlay:                    "KEY_PLAY",
+		KeyFastforward:             "KEY_FASTFORWARD",
+		KeyBassboost:               "KEY_BASSBOOST",
+		KeyPrint:                   "KEY_PRINT",
+		KeyHp:                      "KEY_HP",
+		KeyCamera:                  "KEY_CAMERA",
+		KeySound:                   "KEY_SOUND",
+		KeyQuestion:                "KEY_QUESTION",
+		KeyEmail:                   "KEY_EMAIL",
+		KeyChat:                    "KEY_CHAT",
+		KeySearch:                  "KEY_SEARCH",
+		KeyConnect:                 "KEY_CONNECT",
+		KeyFinance:                 "KEY_FINANCE",
+		KeySport:                   "KEY_SPORT",
+		KeyShop:                    "KEY_SHOP",
+		KeyAlterase:                "KEY_ALTERASE",
+		KeyCancel:                  "KEY_CANCEL",
+		KeyBrightnessdown:          "KEY_BRIGHTNESSDOWN",
+		KeyBrightnessup:            "KEY_BRIGHTNESSUP",
+		KeyMedia:                   "KEY_MEDIA",
+		KeySwitchvideomode:         "KEY_SWITCHVIDEOMODE",
+		KeyKbdillumtoggle:          "KEY_KBDILLUMTOGGLE",
+		KeyKbdillumdown:            "KEY_KBDILLUMDOWN",
+		KeyKbdillumup:              "KEY_KBDILLUMUP",
+		KeySend:                    "KEY_SEND",
+		KeyReply:                   "KEY_REPLY",
+		KeyForwardmail:             "KEY_FORWARDMAIL",
+		KeySave:                    "KEY_SAVE",
+		KeyDocuments:               "KEY_DOCUMENTS",
+		KeyBattery:                 "KEY_BATTERY",
+		KeyBluetooth:               "KEY_BLUETOOTH",
+		KeyWlan:                    "KEY_WLAN",
+		KeyUwb:                     "KEY_UWB",
+		KeyUnknown:                 "KEY_UNKNOWN",
+		KeyVideoNext:               "KEY_VIDEO_NEXT",
+		KeyVideoPrev:               "KEY_VIDEO_PREV",
+		KeyBrightnessCycle:         "KEY_BRIGHTNESS_CYCLE",
+		KeyBrightnessAuto:          "KEY_BRIGHTNESS_AUTO",
+		KeyDisplayOff:              "KEY_DISPLAY_OFF",
+		KeyWwan:                    "KEY_WWAN",
+		KeyRfkill:                  "KEY_RFKILL",
+		KeyMicmute:                 "KEY_MICMUTE",
+		Btn0:                       "BTN_0",
+		Btn1:                       "BTN_1",
+		Btn2:                       "BTN_2",
+		Btn3:                       "BTN_3",
+		Btn4:                       "BTN_4",
+		Btn5:                       "BTN_5",
+		Btn6:                       "BTN_6",
+		Btn7:                       "BTN_7",
+		Btn8:                       "BTN_8",
+		Btn9:                       "BTN_9",
+		BtnLeft:                    "BTN_LEFT",
+		BtnRight:                   "BTN_RIGHT",
+		BtnMiddle:                  "BTN_MIDDLE",
+		BtnSide:                    "BTN_SIDE",
+		BtnExtra:                   "BTN_EXTRA",
+		BtnForward:                 "BTN_FORWARD",
+		BtnBack:                    "BTN_BACK",
+		BtnTask:                    "BTN_TASK",
+		BtnTrigger:                 "BTN_TRIGGER",
+		BtnThumb:                   "BTN_THUMB",
+		BtnThumb2:                  "BTN_THUMB2",
+		BtnTop:                     "BTN_TOP",
+		BtnTop2:                    "BTN_TOP2",
+		BtnPinkie:                  "BTN_PINKIE",
+		BtnBase:                    "BTN_BASE",
+		BtnBase2:                   "BTN_BASE2",
+		BtnBase3:                   "BTN_BASE3",
+		BtnBase4:                   "BTN_BASE4",
+		BtnBase5:                   "BTN_BASE5",
+		BtnBase6:                   "BTN_BASE6",
+		BtnDead:                    "BTN_DEAD",
+		BtnSouth:                   "BTN_SOUTH",
+		BtnEast:                    "BTN_EAST",
+		BtnC:                       "BTN_C",
+		BtnNorth:                   "BTN_NORTH",
+		BtnWest:                    "BTN_WEST",
+		BtnZ:                       "BTN_Z",
+		BtnTl:                      "BTN_TL",
+		BtnTr:                      "BTN_TR",
+		BtnTl2:                     "BTN_TL2",
+		BtnTr2:                     "BTN_TR2",
+		BtnSelect:                  "BTN_SELECT",
+		BtnStart:                   "BTN_START",
+		BtnMode:                    "BTN_MODE",
+		BtnThumbl:                  "BTN_THUMBL",
+		BtnThumbr:                  "BTN_THUMBR",
+		BtnToolPen:                 "BTN_TOOL_PEN",
+		BtnToolRubber:              "BTN_TOOL_RUBBER",
+		BtnToolBrush:               "BTN_TOOL_BRUSH",
+		BtnToolPencil:              "BTN_TOOL_PENCIL",
+		BtnToolAirbrush:            "BTN_TOOL_AIRBRUSH",
+		BtnToolFinger:              "BTN_TOOL_FINGER",
+		BtnToolMouse:               "BTN_TOOL_MOUSE",
+		BtnToolLens:                "BTN_TOOL_LENS",
+		BtnToolQuinttap:            "BTN_TOOL_QUINTTAP",
+		BtnStylus3:                 "BTN_STYLUS3",
+		BtnTouch:                   "BTN_TOUCH",
+		BtnStylus:                  "BTN_STYLUS",
+		BtnStylus2:                 "BTN_STYLUS2",
+		BtnToolDoubletap:           "BTN_TOOL_DOUBLETAP",
+		BtnToolTripletap:           "BTN_TOOL_TRIPLETAP",
+		BtnToolQuadtap:             "BTN_TOOL_QUADTAP",
+		BtnGearDown:                "BTN_GEAR_DOWN",
+		BtnGearUp:                  "BTN_GEAR_UP",
+		KeyOk:                      "KEY_OK",
+		KeySelect:                  "KEY_SELECT",
+		KeyGoto:                    "KEY_GOTO",
+		KeyClear:                   "KEY_CLEAR",
+		KeyPower2:                  "KEY_POWER2",
+		KeyOption:                  "KEY_OPTION",
+		KeyInfo:                    "KEY_INFO",
+		KeyTime:                    "KEY_TIME",
+		KeyVendor:                  "KEY_VENDOR",
+		KeyArchive:                 "KEY_ARCHIVE",
+		KeyProgram:                 "KEY_PROGRAM",
+		KeyChannel:                 "KEY_CHANNEL",
+		KeyFavorites:               "KEY_FAVORITES",
+		KeyEpg:                     "KEY_EPG",
+		KeyPvr:                     "KEY_PVR",
+		KeyMhp:                     "KEY_MHP",
+		KeyLanguage:                "KEY_LANGUAGE",
+		KeyTitle:                   "KEY_TITLE",
+		KeySubtitle:                "KEY_SUBTITLE",
+		KeyAngle:                   "KEY_ANGLE",
+		KeyFullScreen:              "KEY_FULL_SCREEN",
+		KeyMode:                    "KEY_MODE",
+		KeyKeyboard:                "KEY_KEYBOARD",
+		KeyAspectRatio:             "KEY_ASPECT_RATIO",
+		KeyPc:                      "KEY_PC",
+		KeyTv:                      "KEY_TV",
+		KeyTv2:                     "KEY_TV2",
+		KeyVcr:                     "KEY_VCR",
+		KeyVcr2:                    "KEY_VCR2",
+		KeySat:                     "KEY_SAT",
+		KeySat2:                    "KEY_SAT2",
+		KeyCd:                      "KEY_CD",
+		KeyTape:                    "KEY_TAPE",
+		KeyRadio:                   "KEY_RADIO",
+		KeyTuner:                   "KEY_TUNER",
+		KeyPlayer:                  "KEY_PLAYER",
+		KeyText:                    "KEY_TEXT",
+		KeyDvd:                     "KEY_DVD",
+		KeyAux:                     "KEY_AUX",
+		KeyMp3:                     "KEY_MP3",
+		KeyAudio:                   "KEY_AUDIO",
+		KeyVideo:                   "KEY_VIDEO",
+		KeyDirectory:               "KEY_DIRECTORY",
+		KeyList:                    "KEY_LIST",
+		KeyMemo:                    "KEY_MEMO",
+		KeyCalendar:                "KEY_CALENDAR",
+		KeyRed:                     "KEY_RED",
+		KeyGreen:                   "KEY_GREEN",
+		KeyYellow:                  "KEY_YELLOW",
+		KeyBlue:                    "KEY_BLUE",
+		KeyChannelup:               "KEY_CHANNELUP",
+		KeyChanneldown:             "KEY_CHANNELDOWN",
+		KeyFirst:                   "KEY_FIRST",
+		KeyLast:                    "KEY_LAST",
+		KeyAb:                      "KEY_AB",
+		KeyNext:                    "KEY_NEXT",
+		KeyRestart:                 "KEY_RESTART",
+		KeySlow:                    "KEY_SLOW",
+		KeyShuffle:                 "KEY_SHUFFLE",
+		KeyBreak:                   "KEY_BREAK",
+		KeyPrevious:                "KEY_PREVIOUS",
+		KeyDigits:                  "KEY_DIGITS",
+		KeyTeen:                    "KEY_TEEN",
+		KeyTwen:                    "KEY_TWEN",
+		KeyVideophone:              "KEY_VIDEOPHONE",
+		KeyGames:                   "KEY_GAMES",
+		KeyZoomin:                  "KEY_ZOOMIN",
+		KeyZoomout:                 "KEY_ZOOMOUT",
+		KeyZoomreset:               "KEY_ZOOMRESET",
+		KeyWordprocessor:           "KEY_WORDPROCESSOR",
+		KeyEditor:                  "KEY_EDITOR",
+		KeySpreadsheet:             "KEY_SPREADSHEET",
+		KeyGraphicseditor:          "KEY_GRAPHICSEDITOR",
+		KeyPresentation:            "KEY_PRESENTATION",
+		KeyDatabase:                "KEY_DATABASE",
+		KeyNews:                    "KEY_NEWS",
+		KeyVoicemail:               "KEY_VOICEMAIL",
+		KeyAddressbook:             "KEY_ADDRESSBOOK",
+		KeyMessenger:               "KEY_MESSENGER",
+		KeyDisplaytoggle:           "KEY_DISPLAYTOGGLE",
+		KeySpellcheck:              "KEY_SPELLCHECK",
+		KeyLogoff:                  "KEY_LOGOFF",
+		KeyDollar:                  "KEY_DOLLAR",
+		KeyEuro:                    "KEY_EURO",
+		KeyFrameback:               "KEY_FRAMEBACK",
+		KeyFrameforward:            "KEY_FRAMEFORWARD",
+		KeyContextMenu:             "KEY_CONTEXT_MENU",
+		KeyMediaRepeat:             "KEY_MEDIA_REPEAT",
+		Key10Channelsup:            "KEY_10CHANNELSUP",
+		Key10Channelsdown:          "KEY_10CHANNELSDOWN",
+		KeyImages:                  "KEY_IMAGES",
+		KeyNotificationCenter:      "KEY_NOTIFICATION_CENTER",
+		KeyPickupPhone:             "KEY_PICKUP_PHONE",
+		KeyHangupPhone:             "KEY_HANGUP_PHONE",
+		KeyLinkPhone:               "KEY_LINK_PHONE",
+		KeyDelEol:                  "KEY_DEL_EOL",
+		KeyDelEos:                  "KEY_DEL_EOS",
+		KeyInsLine:                 "KEY_INS_LINE",
+		KeyDelLine:                 "KEY_DEL_LINE",
+		KeyFn:                      "KEY_FN",
+		KeyFnEsc:                   "KEY_FN_ESC",
+		KeyFnF1:                    "KEY_FN_F1",
+		KeyFnF2:                    "KEY_FN_F2",
+		KeyFnF3:                    "KEY_FN_F3",
+		KeyFnF4:                    "KEY_FN_F4",
+		KeyFnF5:                    "KEY_FN_F5",
+		KeyFnF6:                    "KEY_FN_F6",
+		KeyFnF7:                    "KEY_FN_F7",
+		KeyFnF8:                    "KEY_FN_F8",
+		KeyFnF9:                    "KEY_FN_F9",
+		KeyFnF10:                   "KEY_FN_F10",
+		KeyFnF11:                   "KEY_FN_F11",
+		KeyFnF12:                   "KEY_FN_F12",
+		KeyFn1:                     "KEY_FN_1",
+		KeyFn2:                     "KEY_FN_2",
+		KeyFnD:                     "KEY_FN_D",
+		KeyFnE:                     "KEY_FN_E",
+		KeyFnF:                     "KEY_FN_F",
+		KeyFnS:                     "KEY_FN_S",
+		KeyFnB:                     "KEY_FN_B",
+		KeyFnRightShift:            "KEY_FN_RIGHT_SHIFT",
+		KeyBrlDot1:                 "KEY_BRL_DOT1",
+		KeyBrlDot2:                 "KEY_BRL_DOT2",
+		KeyBrlDot3:                 "KEY_BRL_DOT3",
+		KeyBrlDot4:                 "KEY_BRL_DOT4",
+		KeyBrlDot5:                 "KEY_BRL_DOT5",
+		KeyBrlDot6:                 "KEY_BRL_DOT6",
+		KeyBrlDot7:                 "KEY_BRL_DOT7",
+		KeyBrlDot8:                 "KEY_BRL_DOT8",
+		KeyBrlDot9:                 "KEY_BRL_DOT9",
+		KeyBrlDot10:                "KEY_BRL_DOT10",
+		KeyNumeric0:                "KEY_NUMERIC_0",
+		KeyNumeric1:                "KEY_NUMERIC_1",
+		KeyNumeric2:                "KEY_NUMERIC_2",
+		KeyNumeric3:                "KEY_NUMERIC_3",
+		KeyNumeric4:                "KEY_NUMERIC_4",
+		KeyNumeric5:                "KEY_NUMERIC_5",
+		KeyNumeric6:                "KEY_NUMERIC_6",
+		KeyNumeric7:                "KEY_NUMERIC_7",
+		KeyNumeric8:                "KEY_NUMERIC_8",
+		KeyNumeric9:                "KEY_NUMERIC_9",
+		KeyNumericStar:             "KEY_NUMERIC_STAR",
+		KeyNumericPound:            "KEY_NUMERIC_POUND",
+		KeyNumericA:                "KEY_NUMERIC_A",
+		KeyNumericB:                "KEY_NUMERIC_B",
+		KeyNumericC:                "KEY_NUMERIC_C",
+		KeyNumericD:                "KEY_NUMERIC_D",
+		KeyCameraFocus:             "KEY_CAMERA_FOCUS",
+		KeyWpsButton:               "KEY_WPS_BUTTON",
+		KeyTouchpadToggle:          "KEY_TOUCHPAD_TOGGLE",
+		KeyTouchpadOn:              "KEY_TOUCHPAD_ON",
+		KeyTouchpadOff:             "KEY_TOUCHPAD_OFF",
+		KeyCameraZoomin:            "KEY_CAMERA_ZOOMIN",
+		KeyCameraZoomout:           "KEY_CAMERA_ZOOMOUT",
+		KeyCameraUp:                "KEY_CAMERA_UP",
+		KeyCameraDown:              "KEY_CAMERA_DOWN",
+		KeyCameraLeft:              "KEY_CAMERA_LEFT",
+		KeyCameraRight:             "KEY_CAMERA_RIGHT",
+		KeyAttendantOn:             "KEY_ATTENDANT_ON",
+		KeyAttendantOff:            "KEY_ATTENDANT_OFF",
+		KeyAttendantToggle:         "KEY_ATTENDANT_TOGGLE",
+		KeyLightsToggle:            "KEY_LIGHTS_TOGGLE",
+		BtnDpadUp:                  "BTN_DPAD_UP",
+		BtnDpadDown:                "BTN_DPAD_DOWN",
+		BtnDpadLeft:                "BTN_DPAD_LEFT",
+		BtnDpadRight:               "BTN_DPAD_RIGHT",
+		KeyAlsToggle:               "KEY_ALS_TOGGLE",
+		KeyRotateLockToggle:        "KEY_ROTATE_LOCK_TOGGLE",
+		KeyRefreshRateToggle:       "KEY_REFRESH_RATE_TOGGLE",
+		KeyButtonconfig:            "KEY_BUTTONCONFIG",
+		KeyTaskmanager:             "KEY_TASKMANAGER",
+		KeyJournal:                 "KEY_JOURNAL",
+		KeyControlpanel:            "KEY_CONTROLPANEL",
+		KeyAppselect:               "KEY_APPSELECT",
+		KeyScreensaver:             "KEY_SCREENSAVER",
+		KeyVoicecommand:            "KEY_VOICECOMMAND",
+		KeyAssistant:               "KEY_ASSISTANT",
+		KeyKbdLayoutNext:           "KEY_KBD_LAYOUT_NEXT",
+		KeyEmojiPicker:             "KEY_EMOJI_PICKER",
+		KeyDictate:                 "KEY_DICTATE",
+		KeyBrightnessMin:           "KEY_BRIGHTNESS_MIN",
+		KeyBrightnessMax:           "KEY_BRIGHTNESS_MAX",
+		KeyKbdinputassistPrev:      "KEY_KBDINPUTASSIST_PREV",
+		KeyKbdinputassistNext:      "KEY_KBDINPUTASSIST_NEXT",
+		KeyKbdinputassistPrevgroup: "KEY_KBDINPUTASSIST_PREVGROUP",
+		KeyKbdinputassistNextgroup: "KEY_KBDINPUTASSIST_NEXTGROUP",
+		KeyKbdinputassistAccept:    "KEY_KBDINPUTASSIST_ACCEPT",
+		KeyKbdinputassistCancel:    "KEY_KBDINPUTASSIST_CANCEL",
+		KeyRightUp:                 "KEY_RIGHT_UP",
+		KeyRightDown:               "KEY_RIGHT_DOWN",
+		KeyLeftUp:                  "KEY_LEFT_UP",
+		KeyLeftDown:                "KEY_LEFT_DOWN",
+		KeyRootMenu:                "KEY_ROOT_MENU",
+		KeyMediaTopMenu:            "KEY_MEDIA_TOP_MENU",
+		KeyNumeric11:               "KEY_NUMERIC_11",
+		KeyNumeric12:               "KEY_NUMERIC_12",
+		KeyAudioDesc:               "KEY_AUDIO_DESC",
+		Key3DMode:                  "KEY_3D_MODE",
+		KeyNextFavorite:            "KEY_NEXT_FAVORITE",
+		KeyStopRecord:              "KEY_STOP_RECORD",
+		KeyPauseRecord:             "KEY_PAUSE_RECORD",
+		KeyVod:                     "KEY_VOD",
+		KeyUnmute:                  "KEY_UNMUTE",
+		KeyFastreverse:             "KEY_FASTREVERSE",
+		KeySlowreverse:             "KEY_SLOWREVERSE",
+		KeyData:                    "KEY_DATA",
+		KeyOnscreenKeyboard:        "KEY_ONSCREEN_KEYBOARD",
+		KeyPrivacyScreenToggle:     "KEY_PRIVACY_SCREEN_TOGGLE",
+		KeySelectiveScreenshot:     "KEY_SELECTIVE_SCREENSHOT",
+		KeyNextElement:             "KEY_NEXT_ELEMENT",
+		KeyPreviousElement:         "KEY_PREVIOUS_ELEMENT",
+		KeyAutopilotEngageToggle:   "KEY_AUTOPILOT_ENGAGE_TOGGLE",
+		KeyMarkWaypoint:            "KEY_MARK_WAYPOINT",
+		KeySos:                     "KEY_SOS",
+		KeyNavChart:                "KEY_NAV_CHART",
+		KeyFishingChart:            "KEY_FISHING_CHART",
+		KeySingleRangeRadar:        "KEY_SINGLE_RANGE_RADAR",
+		KeyDualRangeRadar:          "KEY_DUAL_RANGE_RADAR",
+		KeyRadarOverlay:            "KEY_RADAR_OVERLAY",
+		KeyTraditionalSonar:        "KEY_TRADITIONAL_SONAR",
+		KeyClearvuSonar:            "KEY_CLEARVU_SONAR",
+		KeySidevuSonar:             "KEY_SIDEVU_SONAR",
+		KeyNavInfo:                 "KEY_NAV_INFO",
+		KeyBrightnessMenu:          "KEY_BRIGHTNESS_MENU",
+		KeyMacro1:                  "KEY_MACRO1",
+		KeyMacro2:                  "KEY_MACRO2",
+		KeyMacro3:                  "KEY_MACRO3",
+		KeyMacro4:                  "KEY_MACRO4",
+		KeyMacro5:                  "KEY_MACRO5",
+		KeyMacro6:                  "KEY_MACRO6",
+		KeyMacro7:                  "KEY_MACRO7",
+		KeyMacro8:                  "KEY_MACRO8",
+		KeyMacro9:                  "KEY_MACRO9",
+		KeyMacro10:                 "KEY_MACRO10",
+		KeyMacro11:                 "KEY_MACRO11",
+		KeyMacro12:                 "KEY_MACRO12",
+		KeyMacro13:                 "KEY_MACRO13",
+		KeyMacro14:                 "KEY_MACRO14",
+		KeyMacro15:                 "KEY_MACRO15",
+		KeyMacro16:                 "KEY_MACRO16",
+		KeyMacro17:                 "KEY_MACRO17",
+		KeyMacro18:                 "KEY_MACRO18",
+		KeyMacro19:                 "KEY_MACRO19",
+		KeyMacro20:                 "KEY_MACRO20",
+		KeyMacro21:                 "KEY_MACRO21",
+		KeyMacro22:                 "KEY_MACRO22",
+		KeyMacro23:                 "KEY_MACRO23",
+		KeyMacro24:                 "KEY_MACRO24",
+		KeyMacro25:                 "KEY_MACRO25",
+		KeyMacro26:                 "KEY_MACRO26",
+		KeyMacro27:                 "KEY_MACRO27",
+		KeyMacro28:                 "KEY_MACRO28",
+		KeyMacro29:                 "KEY_MACRO29",
+		KeyMacro30:                 "KEY_MACRO30",
+		KeyMacroRecordStart:        "KEY_MACRO_RECORD_START",
+		KeyMacroRecordStop:         "KEY_MACRO_RECORD_STOP",
+		KeyMacroPresetCycle:        "KEY_MACRO_PRESET_CYCLE",
+		KeyMacroPreset1:            "KEY_MACRO_PRESET1",
+		KeyMacroPreset2:            "KEY_MACRO_PRESET2",
+		KeyMacroPreset3:            "KEY_MACRO_PRESET3",
+		KeyKbdLcdMenu1:             "KEY_KBD_LCD_MENU1",
+		KeyKbdLcdMenu2:             "KEY_KBD_LCD_MENU2",
+		KeyKbdLcdMenu3:             "KEY_KBD_LCD_MENU3",
+		KeyKbdLcdMenu4:             "KEY_KBD_LCD_MENU4",
+		KeyKbdLcdMenu5:             "KEY_KBD_LCD_MENU5",
+		BtnTriggerHappy1:           "BTN_TRIGGER_HAPPY1",
+		BtnTriggerHappy2:           "BTN_TRIGGER_HAPPY2",
+		BtnTriggerHappy3:           "BTN_TRIGGER_HAPPY3",
+		BtnTriggerHappy4:           "BTN_TRIGGER_HAPPY4",
+		BtnTriggerHappy5:           "BTN_TRIGGER_HAPPY5",
+		BtnTriggerHappy6:           "BTN_TRIGGER_HAPPY6",
+		BtnTriggerHappy7:           "BTN_TRIGGER_HAPPY7",
+		BtnTriggerHappy8:           "BTN_TRIGGER_HAPPY8",
+		BtnTriggerHappy9:           "BTN_TRIGGER_HAPPY9",
+		BtnTriggerHappy10:          "BTN_TRIGGER_HAPPY10",
+		BtnTriggerHappy11:          "BTN_TRIGGER_HAPPY11",
+		BtnTriggerHappy12:          "BTN_TRIGGER_HAPPY12",
+		BtnTriggerHappy13:          "BTN_TRIGGER_HAPPY13",
+		BtnTriggerHappy14:          "BTN_TRIGGER_HAPPY14",
+		BtnTriggerHappy15:          "BTN_TRIGGER_HAPPY15",
+		BtnTriggerHappy16:          "BTN_TRIGGER_HAPPY16",
+		BtnTriggerHappy17:          "BTN_TRIGGER_HAPPY17",
+		BtnTriggerHappy18:          "BTN_TRIGGER_HAPPY18",
+		BtnTriggerHappy19:          "BTN_TRIGGER_HAPPY19",
+		BtnTriggerHappy20:          "BTN_TRIGGER_HAPPY20",
+		BtnTriggerHappy21:          "BTN_TRIGGER_HAPPY21",
+		BtnTriggerHappy22:          "BTN_TRIGGER_HAPPY22",
+		BtnTriggerHappy23:          "BTN_TRIGGER_HAPPY23",
+		BtnTriggerHappy24:          "BTN_TRIGGER_HAPPY24",
+		BtnTriggerHappy25:          "BTN_TRIGGER_HAPPY25",
+		BtnTriggerHappy26:          "BTN_TRIGGER_HAPPY26",
+		BtnTriggerHappy27:          "BTN_TRIGGER_HAPPY27",
+		BtnTriggerHappy28:          "BTN_TRIGGER_HAPPY28",
+		BtnTriggerHappy29:          "BTN_TRIGGER_HAPPY29",
+		BtnTriggerHappy30:          "BTN_TRIGGER_HAPPY30",
+		BtnTriggerHappy31:          "BTN_TRIGGER_HAPPY31",
+		BtnTriggerHappy32:          "BTN_TRIGGER_HAPPY32",
+		BtnTriggerHappy33:          "BTN_TRIGGER_HAPPY33",
+		BtnTriggerHappy34:          "BTN_TRIGGER_HAPPY34",
+		BtnTriggerHappy35:          "BTN_TRIGGER_HAPPY35",
+		BtnTriggerHappy36:          "BTN_TRIGGER_HAPPY36",
+		BtnTriggerHappy37:          "BTN_TRIGGER_HAPPY37",
+		BtnTriggerHappy38:          "BTN_TRIGGER_HAPPY38",
+		BtnTriggerHappy39:          "BTN_TRIGGER_HAPPY39",
+		BtnTriggerHappy40:          "BTN_TRIGGER_HAPPY40",
+	},
+	EvRel: {
+		RelX:           "REL_X",
+		RelY:           "REL_Y",
+		RelZ:           "REL_Z",
+		RelRx:          "REL_RX",
+		RelRy:          "REL_RY",
+		RelRz:          "REL_RZ",
+		RelHwheel:      "REL_HWHEEL",
+		RelDial:        "REL_DIAL",
+		RelWheel:       "REL_WHEEL",
+		RelMisc:        "REL_MISC",
+		RelReserved:    "REL_RESERVED",
+		RelWheelHiRes:  "REL_WHEEL_HI_RES",
+		RelHwheelHiRes: "REL_HWHEEL_HI_RES",
+	},
+	EvAbs: {
+		AbsX:             "ABS_X",
+		AbsY:             "ABS_Y",
+		AbsZ:             "ABS_Z",
+		AbsRx:            "ABS_RX",
+		AbsRy:            "ABS_RY",
+		AbsRz:            "ABS_RZ",
+		AbsThrottle:      "ABS_THROTTLE",
+		AbsRudder:        "ABS_RUDDER",
+		AbsWheel:         "ABS_WHEEL",
+		AbsGas:           "ABS_GAS",
+		AbsBrake:         "ABS_BRAKE",
+		AbsHat0X:         "ABS_HAT0X",
+		AbsHat0Y:         "ABS_HAT0Y",
+		AbsHat1X:         "ABS_HAT1X",
+		AbsHat1Y:         "ABS_HAT1Y",
+		AbsHat2X:         "ABS_HAT2X",
+		AbsHat2Y:         "ABS_HAT2Y",
+		AbsHat3X:         "ABS_HAT3X",
+		AbsHat3Y:         "ABS_HAT3Y",
+		AbsPressure:      "ABS_PRESSURE",
+		AbsDistance:      "ABS_DISTANCE",
+		AbsTiltX:         "ABS_TILT_X",
+		AbsTiltY:         "ABS_TILT_Y",
+		AbsToolWidth:     "ABS_TOOL_WIDTH",
+		AbsVolume:        "ABS_VOLUME",
+		AbsProfile:       "ABS_PROFILE",
+		AbsMisc:          "ABS_MISC",
+		AbsReserved:      "ABS_RESERVED",
+		AbsMtSlot:        "ABS_MT_SLOT",
+		AbsMtTouchMajor:  "ABS_MT_TOUCH_MAJOR",
+		AbsMtTouchMinor:  "ABS_MT_TOUCH_MINOR",
+		AbsMtWidthMajor:  "ABS_MT_WIDTH_MAJOR",
+		AbsMtWidthMinor:  "ABS_MT_WIDTH_MINOR",
+		AbsMtOrientation: "ABS_MT_ORIENTATION",
+		AbsMtPositionX:   "ABS_MT_POSITION_X",
+		AbsMtPositionY:   "ABS_MT_POSITION_Y",
+		AbsMtToolType:    "ABS_MT_TOOL_TYPE",
+		AbsMtBlobId:      "ABS_MT_BLOB_ID",
+		AbsMtTrackingId:  "ABS_MT_TRACKING_ID",
+		AbsMtPressure:    "ABS_MT_PRESSURE",
+		AbsMtDistance:    "ABS_MT_DISTANCE",
+		AbsMtToolX:       "ABS_MT_TOOL_X",
+		AbsMtToolY:       "ABS_MT_TOOL_Y",
+	},
+	EvMsc: {
+		MscSerial:    "MSC_SERIAL",
+		MscPulseled:  "MSC_PULSELED",
+		MscGesture:   "MSC_GESTURE",
+		MscRaw:       "MSC_RAW",
+		MscScan:      "MSC_SCAN",
+		MscTimestamp: "MSC_TIMESTAMP",
+	},
+	EvSw: {
+		SwLid:                "SW_LID",
+		SwTabletMode:         "SW_TABLET_MODE",
+		SwHeadphoneInsert:    "SW_HEADPHONE_INSERT",
+		SwRfkillAll:          "SW_RFKILL_ALL",
+		SwMicrophoneInsert:   "SW_MICROPHONE_INSERT",
+		SwDock:               "SW_DOCK",
+		SwLineoutInsert:      "SW_LINEOUT_INSERT",
+		SwJackPhysicalInsert: "SW_JACK_PHYSICAL_INSERT",
+		SwVideooutInsert:     "SW_VIDEOOUT_INSERT",
+		SwCameraLensCover:    "SW_CAMERA_LENS_COVER",
+		SwKeypadSlide:        "SW_KEYPAD_SLIDE",
+		SwFrontProximity:     "SW_FRONT_PROXIMITY",
+		SwRotateLock:         "SW_ROTATE_LOCK",
+		SwLineinInsert:       "SW_LINEIN_INSERT",
+		SwMuteDevice:         "SW_MUTE_DEVICE",
+		SwPenInserted:        "SW_PEN_INSERTED",
+		SwMachineCover:       "SW_MACHINE_COVER",
+	},
+	EvLed: {
+		LedNuml:     "LED_NUML",
+		LedCapsl:    "LED_CAPSL",
+		LedScrolll:  "LED_SCROLLL",
+		LedCompose:  "LED_COMPOSE",
+		LedKana:     "LED_KANA",
+		LedSleep:    "LED_SLEEP",
+		LedSuspend:  "LED_SUSPEND",
+		LedMute:     "LED_MUTE",
+		LedMisc:     "LED_MISC",
+		LedMail:     "LED_MAIL",
+		LedCharging: "LED_CHARGING",
+	},
+	EvSnd: {
+		SndClick: "SND_CLICK",
+		SndBell:  "SND_BELL",
+		SndTone:  "SND_TONE",
+	},
+	EvRep: {
+		RepDelay:  "REP_DELAY",
+		RepPeriod: "REP_PERIOD",
+	},
+	EvFF: {
+		FFRumble:     "FF_RUMBLE",
+		FFPeriodic:   "FF_PERIODIC",
+		FFConstant:   "FF_CONSTANT",
+		FFSpring:     "FF_SPRING",
+		FFFriction:   "FF_FRICTION",
+		FFDamper:     "FF_DAMPER",
+		FFInertia:    "FF_INERTIA",
+		FFRamp:       "FF_RAMP",
+		FFSquare:     "FF_SQUARE",
+		FFTriangle:   "FF_TRIANGLE",
+		FFSine:       "FF_SINE",
+		FFSawUp:      "FF_SAW_UP",
+		FFSawDown:    "FF_SAW_DOWN",
+		FFCustom:     "FF_CUSTOM",
+		FFGain:       "FF_GAIN",
+		FFAutocenter: "FF_AUTOCENTER",
+	},
+	EvFFStatus: {
+		FFStatusStopped: "FF_STATUS_STOPPED",
+		FFStatusPlaying: "FF_STATUS_PLAYING",
+	},
+}
+
+// eventCodesByName maps every code name (including aliases) to its code.
+var eventCodesByName = map[string]EventCode{
+	"SYN_REPORT":                   {EvSyn, SynReport},
+	"SYN_CONFIG":                   {EvSyn, SynConfig},
+	"SYN_MT_REPORT":                {EvSyn, SynMtReport},
+	"SYN_DROPPED":                  {EvSyn, SynDropped},
+	"KEY_RESERVED":                 {EvKey, KeyReserved},
+	"KEY_ESC":                      {EvKey, KeyEsc},
+	"KEY_1":                        {EvKey, Key1},
+	"KEY_2":                        {EvKey, Key2},
+	"KEY_3":                        {EvKey, Key3},
+	"KEY_4":                        {EvKey, Key4},
+	"KEY_5":                        {EvKey, Key5},
+	"KEY_6":                        {EvKey, Key6},
+	"KEY_7":                        {EvKey, Key7},
+	"KEY_8":                        {EvKey, Key8},
+	"KEY_9":                        {EvKey, Key9},
+	"KEY_0":                        {EvKey, Key0},
+	"KEY_MINUS":                    {EvKey, KeyMinus},
+	"KEY_EQUAL":                    {EvKey, KeyEqual},
+	"KEY_BACKSPACE":                {EvKey, KeyBackspace},
+	"KEY_TAB":                      {EvKey, KeyTab},
+	"KEY_Q":                        {EvKey, KeyQ},
+	"KEY_W":                        {EvKey, KeyW},
+	"KEY_E":                        {EvKey, KeyE},
+	"KEY_R":                        {EvKey, KeyR},
+	"KEY_T":                        {EvKey, KeyT},
+	"KEY_Y":                        {EvKey, KeyY},
+	"KEY_U":                        {EvKey, KeyU},
+	"KEY_I":                        {EvKey, KeyI},
+	"KEY_O":                        {EvKey, KeyO},
+	"KEY_P":                        {EvKey, KeyP},
+	"KEY_LEFTBRACE":                {EvKey, KeyLeftbrace},
+	"KEY_RIGHTBRACE":               {EvKey, KeyRightbrace},
+	"KEY_ENTER":                    {EvKey, KeyEnter},
+	"KEY_LEFTCTRL":                 {EvKey, KeyLeftctrl},
+	"KEY_A":                        {EvKey, KeyA},
+	"KEY_S":                        {EvKey, KeyS},
+	"KEY_D":                        {EvKey, KeyD},
+	"KEY_F":                        {EvKey, KeyF},
+	"KEY_G":                        {EvKey, KeyG},
+	"KEY_H":                        {EvKey, KeyH},
+	"KEY_J":                        {EvKey, KeyJ},
+	"KEY_K":                        {EvKey, KeyK},
+	"KEY_L":                        {EvKey, KeyL},
+	"KEY_SEMICOLON":                {EvKey, KeySemicolon},
+	"KEY_APOSTROPHE":               {EvKey, KeyApostrophe},
+	"KEY_GRAVE":                    {EvKey, KeyGrave},
+	"KEY_LEFTSHIFT":                {EvKey, KeyLeftshift},
+	"KEY_BACKSLASH":                {EvKey, KeyBackslash},
+	"KEY_Z":                        {EvKey, KeyZ},
+	"KEY_X":                        {EvKey, KeyX},
+	"KEY_C":                        {EvKey, KeyC},
+	"KEY_V":                        {EvKey, KeyV},
+	"KEY_B":                        {EvKey, KeyB},
+	"KEY_N":                        {EvKey, KeyN},
+	"KEY_M":                        {EvKey, KeyM},
+	"KEY_COMMA":                    {EvKey, KeyComma},
+	"KEY_DOT":                      {EvKey, KeyDot},
+	"KEY_SLASH":                    {EvKey, KeySlash},
+	"KEY_RIGHTSHIFT":               {EvKey, KeyRightshift},
+	"KEY_KPASTERISK":               {EvKey, KeyKpasterisk},
+	"KEY_LEFTALT":                  {EvKey, KeyLeftalt},
+	"KEY_SPACE":                    {EvKey, KeySpace},
+	"KEY_CAPSLOCK":                 {EvKey, KeyCapslock},
+	"KEY_F1":                       {EvKey, KeyF1},
+	"KEY_F2":                       {EvKey, KeyF2},
+	"KEY_F3":                       {EvKey, KeyF3},
+	"KEY_F4":                       {EvKey, KeyF4},
+	"KEY_F5":                       {EvKey, KeyF5},
+	"KEY_F6":                       {EvKey, KeyF6},
+	"KEY_F7":                       {EvKey, KeyF7},
+	"KEY_F8":                       {EvKey, KeyF8},
+	"KEY_F9":                       {EvKey, KeyF9},
+	"KEY_F10":                      {EvKey, KeyF10},
+	"KEY_NUMLOCK":                  {EvKey, KeyNumlock},
+	"KEY_SCROLLLOCK":               {EvKey, KeyScrolllock},
+	"KEY_KP7":                      {EvKey, KeyKp7},
+	"KEY_KP8":                      {EvKey, KeyKp8},
+	"KEY_KP9":                      {EvKey, KeyKp9},
+	"KEY_KPMINUS":                  {EvKey, KeyKpminus},
+	"KEY_KP4":                      {EvKey, KeyKp4},
+	"KEY_KP5":                      {EvKey, KeyKp5},
+	"KEY_KP6":                      {EvKey, KeyKp6},
+	"KEY_KPPLUS":                   {EvKey, KeyKpplus},
+	"KEY_KP1":                      {EvKey, KeyKp1},
+	"KEY_KP2":                      {EvKey, KeyKp2},
+	"KEY_KP3":                      {EvKey, KeyKp3},
+	"KEY_KP0":                      {EvKey, KeyKp0},
+	"KEY_KPDOT":                    {EvKey, KeyKpdot},
+	"KEY_ZENKAKUHANKAKU":           {EvKey, KeyZenkakuhankaku},
+	"KEY_102ND":                    {EvKey, Key102Nd},
+	"KEY_F11":                      {EvKey, KeyF11},
+	"KEY_F12":                      {EvKey, KeyF12},
+	"KEY_RO":                       {EvKey, KeyRo},
+	"KEY_KATAKANA":                 {EvKey, KeyKatakana},
+	"KEY_HIRAGANA":                 {EvKey, KeyHiragana},
+	"KEY_HENKAN":                   {EvKey, KeyHenkan},
+	"KEY_KATAKANAHIRAGANA":         {EvKey, KeyKatakanahiragana},
+	"KEY_MUHENKAN":                 {EvKey, KeyMuhenkan},
+	"KEY_KPJPCOMMA":                {EvKey, KeyKpjpcomma},
+	"KEY_KPENTER":                  {EvKey, KeyKpenter},
+	"KEY_RIGHTCTRL":                {EvKey, KeyRightctrl},
+	"KEY_KPSLASH":                  {EvKey, KeyKpslash},
+	"KEY_SYSRQ":                    {EvKey, KeySysrq},
+	"KEY_RIGHTALT":                 {EvKey, KeyRightalt},
+	"KEY_LINEFEED":                 {EvKey, KeyLinefeed},
+	"KEY_HOME":                     {EvKey, KeyHome},
+	"KEY_UP":                       {EvKey, KeyUp},
+	"KEY_PAGEUP":                   {EvKey, KeyPageup},
+	"KEY_LEFT":                     {EvKey, KeyLeft},
+	"KEY_RIGHT":                    {EvKey, KeyRight},
+	"KEY_END":                      {EvKey, KeyEnd},
+	"KEY_DOWN":                     {EvKey, KeyDown},
+	"KEY_PAGEDOWN":                 {EvKey, KeyPagedown},
+	"KEY_INSERT":                   {EvKey, KeyInsert},
+	"KEY_DELETE":                   {EvKey, KeyDelete},
+	"KEY_MACRO":                    {EvKey, KeyMacro},
+	"KEY_MUTE":                     {EvKey, KeyMute},
+	"KEY_VOLUMEDOWN":               {EvKey, KeyVolumedown},
+	"KEY_VOLUMEUP":                 {EvKey, KeyVolumeup},
+	"KEY_POWER":                    {EvKey, KeyPower},
+	"KEY_KPEQUAL":                  {EvKey, KeyKpequal},
+	"KEY_KPPLUSMINUS":              {EvKey, KeyKpplusminus},
+	"KEY_PAUSE":                    {EvKey, KeyPause},
+	"KEY_SCALE":                    {EvKey, KeyScale},
+	"KEY_KPCOMMA":                  {EvKey, KeyKpcomma},
+	"KEY_HANGEUL":                  {EvKey, KeyHangeul},
+	"KEY_HANGUEL":                  {EvKey, KeyHanguel},
+	"KEY_HANJA":                    {EvKey, KeyHanja},
+	"KEY_YEN":                      {EvKey, KeyYen},
+	"KEY_LEFTMETA":                 {EvKey, KeyLeftmeta},
+	"KEY_RIGHTMETA":                {EvKey, KeyRightmeta},
+	"KEY_COMPOSE":                  {EvKey, KeyCompose},
+	"KEY_STOP":                     {EvKey, KeyStop},
+	"KEY_AGAIN":                    {EvKey, KeyAgain},
+	"KEY_PROPS":                    {EvKey, KeyProps},
+	"KEY_UNDO":                     {EvKey, KeyUndo},
+	"KEY_FRONT":                    {EvKey, KeyFront},
+	"KEY_COPY":                     {EvKey, KeyCopy},
+	"KEY_OPEN":                     {EvKey, KeyOpen},
+	"KEY_PASTE":                    {EvKey, KeyPaste},
+	"KEY_FIND":                     {EvKey, KeyFind},
+	"KEY_CUT":                      {EvKey, KeyCut},
+	"KEY_HELP":                     {EvKey, KeyHelp},
+	"KEY_MENU":                     {EvKey, KeyMenu},
+	"KEY_CALC":                     {EvKey, KeyCalc},
+	"KEY_SETUP":                    {EvKey, KeySetup},
+	"KEY_SLEEP":                    {EvKey, KeySleep},
+	"KEY_WAKEUP":                   {EvKey, KeyWakeup},
+	"KEY_FILE":                     {EvKey, KeyFile},
+	"KEY_SENDFILE":                 {EvKey, KeySendfile},
+	"KEY_DELETEFILE":               {EvKey, KeyDeletefile},
+	"KEY_XFER":                     {EvKey, KeyXfer},
+	"KEY_PROG1":                    {EvKey, KeyProg1},
+	"KEY_PROG2":                    {EvKey, KeyProg2},
+	"KEY_WWW":                      {EvKey, KeyWww},
+	"KEY_MSDOS":                    {EvKey, KeyMsdos},
+	"KEY_COFFEE":                   {EvKey, KeyCoffee},
+	"KEY_SCREENLOCK":               {EvKey, KeyScreenlock},
+	"KEY_ROTATE_DISPLAY":           {EvKey, KeyRotateDisplay},
+	"KEY_DIRECTION":                {EvKey, KeyDirection},
+	"KEY_CYCLEWINDOWS":             {EvKey, KeyCyclewindows},
+	"KEY_MAIL":                     {EvKey, KeyMail},
+	"KEY_BOOKMARKS":                {EvKey, KeyBookmarks},
+	"KEY_COMPUTER":                 {EvKey, KeyComputer},
+	"KEY_BACK":                     {EvKey, KeyBack},
+	"KEY_FORWARD":                  {EvKey, KeyForward},
+	"KEY_CLOSECD":                  {EvKey, KeyClosecd},
+	"KEY_EJECTCD":                  {EvKey, KeyEjectcd},
+	"KEY_EJECTCLOSECD":             {EvKey, KeyEjectclosecd},
+	"KEY_NEXTSONG":                 {EvKey, KeyNextsong},
+	"KEY_PLAYPAUSE":                {EvKey, KeyPlaypause},
+	"KEY_PREVIOUSSONG":             {EvKey, KeyPrevioussong},
+	"KEY_STOPCD":                   {EvKey, KeyStopcd},
+	"KEY_RECORD":                   {EvKey, KeyRecord},
+	"KEY_REWIND":                   {EvKey, KeyRewind},
+	"KEY_PHONE":                    {EvKey, KeyPhone},
+	"KEY_ISO":                      {EvKey, KeyIso},
+	"KEY_CONFIG":                   {EvKey, KeyConfig},
+	"KEY_HOMEPAGE":                 {EvKey, KeyHomepage},
+	"KEY_REFRESH":                  {EvKey, KeyRefresh},
+	"KEY_EXIT":                     {EvKey, KeyExit},
+	"KEY_MOVE":                     {EvKey, KeyMove},
+	"KEY_EDIT":                     {EvKey, KeyEdit},
+	"KEY_SCROLLUP":                 {EvKey, KeyScrollup},
+	"KEY_SCROLLDOWN":               {EvKey, KeyScrolldown},
+	"KEY_KPLEFTPAREN":              {EvKey, KeyKpleftparen},
+	"KEY_KPRIGHTPAREN":             {EvKey, KeyKprightparen},
+	"KEY_NEW":                      {EvKey, KeyNew},
+	"KEY_REDO":                     {EvKey, KeyRedo},
+	"KEY_F13":                      {EvKey, KeyF13},
+	"KEY_F14":                      {EvKey, KeyF14},
+	"KEY_F15":                      {EvKey, KeyF15},
+	"KEY_F16":                      {EvKey, KeyF16},
+	"KEY_F17":                      {EvKey, KeyF17},
+	"KEY_F18":                      {EvKey, KeyF18},
+	"KEY_F19":                      {EvKey, KeyF19},
+	"KEY_F20":                      {EvKey, KeyF20},
+	"KEY_F21":                      {EvKey, KeyF21},
+	"KEY_F22":                      {EvKey, KeyF22},
+	"KEY_F23":                      {EvKey, KeyF23},
+	"KEY_F24":                      {EvKey, KeyF24},
+	"KEY_PLAYCD":                   {EvKey, KeyPlaycd},
+	"KEY_PAUSECD":                  {EvKey, KeyPausecd},
+	"KEY_PROG3":                    {EvKey, KeyProg3},
+	"KEY_PROG4":                    {EvKey, KeyProg4},
+	"KEY_ALL_APPLICATIONS":         {EvKey, KeyAllApplications},
+	"KEY_DASHBOARD":                {EvKey, KeyDashboard},
+	"KEY_SUSPEND":                  {EvKey, KeySuspend},
+	"KEY_CLOSE":                    {EvKey, KeyClose},
+	"KEY_PLAY":                     {EvKey, KeyPlay},
+	"KEY_FASTFORWARD":              {EvKey, KeyFastforward},
+	"KEY_BASSBOOST":                {EvKey, KeyBassboost},
+	"KEY_PRINT":                    {EvKey, KeyPrint},
+	"KEY_HP":                       {EvKey, KeyHp},
+	"KEY_CAMERA":                   {EvKey, KeyCamera},
+	"KEY_SOUND":                    {EvKey, KeySound},
+	"KEY_QUESTION":                 {EvKey, KeyQuestion},
+	"KEY_EMAIL":                    {EvKey, KeyEmail},
+	"KEY_CHAT":                     {EvKey, KeyChat},
+	"KEY_SEARCH":                   {EvKey, KeySearch},
+	"KEY_CONNECT":                  {EvKey, KeyConnect},
+	"KEY_FINANCE":                  {EvKey, KeyFinance},
+	"KEY_SPORT":                    {EvKey, KeySport},
+	"KEY_SHOP":                     {EvKey, KeyShop},
+	"KEY_ALTERASE":                 {EvKey, KeyAlterase},
+	"KEY_CANCEL":                   {EvKey, KeyCancel},
+	"KEY_BRIGHTNESSDOWN":           {EvKey, KeyBrightnessdown},
+	"KEY_BRIGHTNESSUP":             {EvKey, KeyBrightnessup},
+	"KEY_MEDIA":                    {EvKey, KeyMedia},
+	"KEY_SWITCHVIDEOMODE":          {EvKey, KeySwitchvideomode},
+	"KEY_KBDILLUMTOGGLE":           {EvKey, KeyKbdillumtoggle},
+	"KEY_KBDILLUMDOWN":             {EvKey, KeyKbdillumdown},
+	"KEY_KBDILLUMUP":               {EvKey, KeyKbdillumup},
+	"KEY_SEND":                     {EvKey, KeySend},
+	"KEY_REPLY":                    {EvKey, KeyReply},
+	"KEY_FORWARDMAIL":              {EvKey, KeyForwardmail},
+	"KEY_SAVE":                     {EvKey, KeySave},
+	"KEY_DOCUMENTS":                {EvKey, KeyDocuments},
+	"KEY_BATTERY":                  {EvKey, KeyBattery},
+	"KEY_BLUETOOTH":                {EvKey, KeyBluetooth},
+	"KEY_WLAN":                     {EvKey, KeyWlan},
+	"KEY_UWB":                      {EvKey, KeyUwb},
+	"KEY_UNKNOWN":                  {EvKey, KeyUnknown},
+	"KEY_VIDEO_NEXT":               {EvKey, KeyVideoNext},
+	"KEY_VIDEO_PREV":               {EvKey, KeyVideoPrev},
+	"KEY_BRIGHTNESS_CYCLE":         {EvKey, KeyBrightnessCycle},
+	"KEY_BRIGHTNESS_AUTO":          {EvKey, KeyBrightnessAuto},
+	"KEY_BRIGHTNESS_ZERO":          {EvKey, KeyBrightnessZero},
+	"KEY_DISPLAY_OFF":              {EvKey, KeyDisplayOff},
+	"KEY_WWAN":                     {EvKey, KeyWwan},
+	"KEY_WIMAX":                    {EvKey, KeyWimax},
+	"KEY_RFKILL":                   {EvKey, KeyRfkill},
+	"KEY_MICMUTE":                  {EvKey, KeyMicmute},
+	"BTN_MISC":                     {EvKey, BtnMisc},
+	"BTN_0":                        {EvKey, Btn0},
+	"BTN_1":                        {EvKey, Btn1},
+	"BTN_2":                        {EvKey, Btn2},
+	"BTN_3":                        {EvKey, Btn3},
+	"BTN_4":                        {EvKey, Btn4},
+	"BTN_5":                        {EvKey, Btn5},
+	"BTN_6":                        {EvKey, Btn6},
+	"BTN_7":                        {EvKey, Btn7},
+	"BTN_8":                        {EvKey, Btn8},
+	"BTN_9":                        {EvKey, Btn9},
+	"BTN_MOUSE":                    {EvKey, BtnMouse},
+	"BTN_LEFT":                     {EvKey, BtnLeft},
+	"BTN_RIGHT":                    {EvKey, BtnRight},
+	"BTN_MIDDLE":                   {EvKey, BtnMiddle},
+	"BTN_SIDE":                     {EvKey, BtnSide},
+	"BTN_EXTRA":                    {EvKey, BtnExtra},
+	"BTN_FORWARD":                  {EvKey, BtnForward},
+	"BTN_BACK":                     {EvKey, BtnBack},
+	"BTN_TASK":                     {EvKey, BtnTask},
+	"BTN_JOYSTICK":                 {EvKey, BtnJoystick},
+	"BTN_TRIGGER":                  {EvKey, BtnTrigger},
+	"BTN_THUMB":                    {EvKey, BtnThumb},
+	"BTN_THUMB2":                   {EvKey, BtnThumb2},
+	"BTN_TOP":                      {EvKey, BtnTop},
+	"BTN_TOP2":                     {EvKey, BtnTop2},
+	"BTN_PINKIE":                   {EvKey, BtnPinkie},
+	"BTN_BASE":                     {EvKey, BtnBase},
+	"BTN_BASE2":                    {EvKey, BtnBase2},
+	"BTN_BASE3":                    {EvKey, BtnBase3},
+	"BTN_BASE4":                    {EvKey, BtnBase4},
+	"BTN_BASE5":                    {EvKey, BtnBase5},
+	"BTN_BASE6":                    {EvKey, BtnBase6},
+	"BTN_DEAD":                     {EvKey, BtnDead},
+	"BTN_GAMEPAD":                  {EvKey, BtnGamepad},
+	"BTN_SOUTH":                    {EvKey, BtnSouth},
+	"BTN_A":                        {EvKey, BtnA},
+	"BTN_EAST":                     {EvKey, BtnEast},
+	"BTN_B":                        {EvKey, BtnB},
+	"BTN_C":                        {EvKey, BtnC},
+	"BTN_NORTH":                    {EvKey, BtnNorth},
+	"BTN_X":                        {EvKey, BtnX},
+	"BTN_WEST":                     {EvKey, BtnWest},
+	"BTN_Y":                        {EvKey, BtnY},
+	"BTN_Z":                        {EvKey, BtnZ},
+	"BTN_TL":                       {EvKey, BtnTl},
+	"BTN_TR":                       {EvKey, BtnTr},
+	"BTN_TL2":                      {EvKey, BtnTl2},
+	"BTN_TR2":                      {EvKey, BtnTr2},
+	"BTN_SELECT":                   {EvKey, BtnSelect},
+	"BTN_START":                    {EvKey, BtnStart},
+	"BTN_MODE":                     {EvKey, BtnMode},
+	"BTN_THUMBL":                   {EvKey, BtnThumbl},
+	"BTN_THUMBR":                   {EvKey, BtnThumbr},
+	"BTN_DIGI":                     {EvKey, BtnDigi},
+	"BTN_TOOL_PEN":                 {EvKey, BtnToolPen},
+	"BTN_TOOL_RUBBER":              {EvKey, BtnToolRubber},
+	"BTN_TOOL_BRUSH":               {EvKey, BtnToolBrush},
+	"BTN_TOOL_PENCIL":              {EvKey, BtnToolPencil},
+	"BTN_TOOL_AIRBRUSH":            {EvKey, BtnToolAirbrush},
+	"BTN_TOOL_FINGER":              {EvKey, BtnToolFinger},
+	"BTN_TOOL_MOUSE":               {EvKey, BtnToolMouse},
+	"BTN_TOOL_LENS":                {EvKey, BtnToolLens},
+	"BTN_TOOL_QUINTTAP":            {EvKey, BtnToolQuinttap},
+	"BTN_STYLUS3":                  {EvKey, BtnStylus3},
+	"BTN_TOUCH":                    {EvKey, BtnTouch},
+	"BTN_STYLUS":                   {EvKey, BtnStylus},
+	"BTN_STYLUS2":                  {EvKey, BtnStylus2},
+	"BTN_TOOL_DOUBLETAP":           {EvKey, BtnToolDoubletap},
+	"BTN_TOOL_TRIPLETAP":           {EvKey, BtnToolTripletap},
+	"BTN_TOOL_QUADTAP":             {EvKey, BtnToolQuadtap},
+	"BTN_WHEEL":                    {EvKey, BtnWheel},
+	"BTN_GEAR_DOWN":                {EvKey, BtnGearDown},
+	"BTN_GEAR_UP":                  {EvKey, BtnGearUp},
+	"KEY_OK":                       {EvKey, KeyOk},
+	"KEY_SELECT":                   {EvKey, KeySelect},
+	"KEY_GOTO":                     {EvKey, KeyGoto},
+	"KEY_CLEAR":                    {EvKey, KeyClear},
+	"KEY_POWER2":                   {EvKey, KeyPower2},
+	"KEY_OPTION":                   {EvKey, KeyOption},
+	"KEY_INFO":                     {EvKey, KeyInfo},
+	"KEY_TIME":                     {EvKey, KeyTime},
+	"KEY_VENDOR":                   {EvKey, KeyVendor},
+	"KEY_ARCHIVE":                  {EvKey, KeyArchive},
+	"KEY_PROGRAM":                  {EvKey, KeyProgram},
+	"KEY_CHANNEL":                  {EvKey, KeyChannel},
+	"KEY_FAVORITES":                {EvKey, KeyFavorites},
+	"KEY_EPG":                      {EvKey, KeyEpg},
+	"KEY_PVR":                      {EvKey, KeyPvr},
+	"KEY_MHP":                      {EvKey, KeyMhp},
+	"KEY_LANGUAGE":                 {EvKey, KeyLanguage},
+	"KEY_TITLE":                    {EvKey, KeyTitle},
+	"KEY_SUBTITLE":                 {EvKey, KeySubtitle},
+	"KEY_ANGLE":                    {EvKey, KeyAngle},
+	"KEY_FULL_SCREEN":              {EvKey, KeyFullScreen},
+	"KEY_ZOOM":                     {EvKey, KeyZoom},
+	"KEY_MODE":                     {EvKey, KeyMode},
+	"KEY_KEYBOARD":                 {EvKey, KeyKeyboard},
+	"KEY_ASPECT_RATIO":             {EvKey, KeyAspectRatio},
+	"KEY_SCREEN":                   {EvKey, KeyScreen},
+	"KEY_PC":                       {EvKey, KeyPc},
+	"KEY_TV":                       {EvKey, KeyTv},
+	"KEY_TV2":                      {EvKey, KeyTv2},
+	"KEY_VCR":                      {EvKey, KeyVcr},
+	"KEY_VCR2":                     {EvKey, KeyVcr2},
+	"KEY_SAT":                      {EvKey, KeySat},
+	"KEY_SAT2":                     {EvKey, KeySat2},
+	"KEY_CD":                       {EvKey, KeyCd},
+	"KEY_TAPE":                     {EvKey, KeyTape},
+	"KEY_RADIO":                    {EvKey, KeyRadio},
+	"KEY_TUNER":                    {EvKey, KeyTuner},
+	"KEY_PLAYER":                   {EvKey, KeyPlayer},
+	"KEY_TEXT":                     {EvKey, KeyText},
+	"KEY_DVD":                      {EvKey, KeyDvd},
+	"KEY_AUX":                      {EvKey, KeyAux},
+	"KEY_MP3":                      {EvKey, KeyMp3},
+	"KEY_AUDIO":                    {EvKey, KeyAudio},
+	"KEY_VIDEO":                    {EvKey, KeyVideo},
+	"KEY_DIRECTORY":                {EvKey, KeyDirectory},
+	"KEY_LIST":                     {EvKey, KeyList},
+	"KEY_MEMO":                     {EvKey, KeyMemo},
+	"KEY_CALENDAR":                 {EvKey, KeyCalendar},
+	"KEY_RED":                      {EvKey, KeyRed},
+	"KEY_GREEN":                    {EvKey, KeyGreen},
+	"KEY_YELLOW":                   {EvKey, KeyYellow},
+	"KEY_BLUE":                     {EvKey, KeyBlue},
+	"KEY_CHANNELUP":                {EvKey, KeyChannelup},
+	"KEY_CHANNELDOWN":              {EvKey, KeyChanneldown},
+	"KEY_FIRST":                    {EvKey, KeyFirst},
+	"KEY_LAST":                     {EvKey, KeyLast},
+	"KEY_AB":                       {EvKey, KeyAb},
+	"KEY_NEXT":                     {EvKey, KeyNext},
+	"KEY_RESTART":                  {EvKey, KeyRestart},
+	"KEY_SLOW":                     {EvKey, KeySlow},
+	"KEY_SHUFFLE":                  {EvKey, KeyShuffle},
+	"KEY_BREAK":                    {EvKey, KeyBreak},
+	"KEY_PREVIOUS":                 {EvKey, KeyPrevious},
+	"KEY_DIGITS":                   {EvKey, KeyDigits},
+	"KEY_TEEN":                     {EvKey, KeyTeen},
+	"KEY_TWEN":                     {EvKey, KeyTwen},
+	"KEY_VIDEOPHONE":               {EvKey, KeyVideophone},
+	"KEY_GAMES":                    {EvKey, KeyGames},
+	"KEY_ZOOMIN":                   {EvKey, KeyZoomin},
+	"KEY_ZOOMOUT":                  {EvKey, KeyZoomout},
+	"KEY_ZOOMRESET":                {EvKey, KeyZoomreset},
+	"KEY_WORDPROCESSOR":            {EvKey, KeyWordprocessor},
+	"KEY_EDITOR":                   {EvKey, KeyEditor},
+	"KEY_SPREADSHEET":              {EvKey, KeySpreadsheet},
+	"KEY_GRAPHICSEDITOR":           {EvKey, KeyGraphicseditor},
+	"KEY_PRESENTATION":             {EvKey, KeyPresentation},
+	"KEY_DATABASE":                 {EvKey, KeyDatabase},
+	"KEY_NEWS":                     {EvKey, KeyNews},
+	"KEY_VOICEMAIL":                {EvKey, KeyVoicemail},
+	"KEY_ADDRESSBOOK":              {EvKey, KeyAddressbook},
+	"KEY_MESSENGER":                {EvKey, KeyMessenger},
+	"KEY_DISPLAYTOGGLE":            {EvKey, KeyDisplaytoggle},
+	"KEY_BRIGHTNESS_TOGGLE":        {EvKey, KeyBrightnessToggle},
+	"KEY_SPELLCHECK":               {EvKey, KeySpellcheck},
+	"KEY_LOGOFF":                   {EvKey, KeyLogoff},
+	"KEY_DOLLAR":                   {EvKey, KeyDollar},
+	"KEY_EURO":                     {EvKey, KeyEuro},
+	"KEY_FRAMEBACK":                {EvKey, KeyFrameback},
+	"KEY_FRAMEFORWARD":             {EvKey, KeyFrameforward},
+	"KEY_CONTEXT_MENU":             {EvKey, KeyContextMenu},
+	"KEY_MEDIA_REPEAT":             {EvKey, KeyMediaRepeat},
+	"KEY_10CHANNELSUP":             {EvKey, Key10Channelsup},
+	"KEY_10CHANNELSDOWN":           {EvKey, Key10Channelsdown},
+	"KEY_IMAGES":                   {EvKey, KeyImages},
+	"KEY_NOTIFICATION_CENTER":      {EvKey, KeyNotificationCenter},
+	"KEY_PICKUP_PHONE":             {EvKey, KeyPickupPhone},
+	"KEY_HANGUP_PHONE":             {EvKey, KeyHangupPhone},
+	"KEY_LINK_PHONE":               {EvKey, KeyLinkPhone},
+	"KEY_DEL_EOL":                  {EvKey, KeyDelEol},
+	"KEY_DEL_EOS":                  {EvKey, KeyDelEos},
+	"KEY_INS_LINE":                 {EvKey, KeyInsLine},
+	"KEY_DEL_LINE":                 {EvKey, KeyDelLine},
+	"KEY_FN":                       {EvKey, KeyFn},
+	"KEY_FN_ESC":                   {EvKey, KeyFnEsc},
+	"KEY_FN_F1":                    {EvKey, KeyFnF1},
+	"KEY_FN_F2":                    {EvKey, KeyFnF2},
+	"KEY_FN_F3":                    {EvKey, KeyFnF3},
+	"KEY_FN_F4":                    {EvKey, KeyFnF4},
+	"KEY_FN_F5":                    {EvKey, KeyFnF5},
+	"KEY_FN_F6":                    {EvKey, KeyFnF6},
+	"KEY_FN_F7":                    {EvKey, KeyFnF7},
+	"KEY_FN_F8":                    {EvKey, KeyFnF8},
+	"KEY_FN_F9":                    {EvKey, KeyFnF9},
+	"KEY_FN_F10":                   {EvKey, KeyFnF10},
+	"KEY_FN_F11":                   {EvKey, KeyFnF11},
+	"KEY_FN_F12":                   {EvKey, KeyFnF12},
+	"KEY_FN_1":                     {EvKey, KeyFn1},
+	"KEY_FN_2":                     {EvKey, KeyFn2},
+	"KEY_FN_D":                     {EvKey, KeyFnD},
+	"KEY_FN_E":                     {EvKey, KeyFnE},
+	"KEY_FN_F":                     {EvKey, KeyFnF},
+	"KEY_FN_S":                     {EvKey, KeyFnS},
+	"KEY_FN_B":                     {EvKey, KeyFnB},
+	"KEY_FN_RIGHT_SHIFT":           {EvKey, KeyFnRightShift},
+	"KEY_BRL_DOT1":                 {EvKey, KeyBrlDot1},
+	"KEY_BRL_DOT2":                 {EvKey, KeyBrlDot2},
+	"KEY_BRL_DOT3":                 {EvKey, KeyBrlDot3},
+	"KEY_BRL_DOT4":                 {EvKey, KeyBrlDot4},
+	"KEY_BRL_DOT5":                 {EvKey, KeyBrlDot5},
+	"KEY_BRL_DOT6":                 {EvKey, KeyBrlDot6},
+	"KEY_BRL_DOT7":                 {EvKey, KeyBrlDot7},
+	"KEY_BRL_DOT8":                 {EvKey, KeyBrlDot8},
+	"KEY_BRL_DOT9":                 {EvKey, KeyBrlDot9},
+	"KEY_BRL_DOT10":                {EvKey, KeyBrlDot10},
+	"KEY_NUMERIC_0":                {EvKey, KeyNumeric0},
+	"KEY_NUMERIC_1":                {EvKey, KeyNumeric1},
+	"KEY_NUMERIC_2":                {EvKey, KeyNumeric2},
+	"KEY_NUMERIC_3":                {EvKey, KeyNumeric3},
+	"KEY_NUMERIC_4":                {EvKey, KeyNumeric4},
+	"KEY_NUMERIC_5":                {EvKey, KeyNumeric5},
+	"KEY_NUMERIC_6":                {EvKey, KeyNumeric6},
+	"KEY_NUMERIC_7":                {EvKey, KeyNumeric7},
+	"KEY_NUMERIC_8":                {EvKey, KeyNumeric8},
+	"KEY_NUMERIC_9":                {EvKey, KeyNumeric9},
+	"KEY_NUMERIC_STAR":             {EvKey, KeyNumericStar},
+	"KEY_NUMERIC_POUND":            {EvKey, KeyNumericPound},
+	"KEY_NUMERIC_A":                {EvKey, KeyNumericA},
+	"KEY_NUMERIC_B":                {EvKey, KeyNumericB},
+	"KEY_NUMERIC_C":                {EvKey, KeyNumericC},
+	"KEY_NUMERIC_D":                {EvKey, KeyNumericD},
+	"KEY_CAMERA_FOCUS":             {EvKey, KeyCameraFocus},
+	"KEY_WPS_BUTTON":               {EvKey, KeyWpsButton},
+	"KEY_TOUCHPAD_TOGGLE":          {EvKey, KeyTouchpadToggle},
+	"KEY_TOUCHPAD_ON":              {EvKey, KeyTouchpadOn},
+	"KEY_TOUCHPAD_OFF":             {EvKey, KeyTouchpadOff},
+	"KEY_CAMERA_ZOOMIN":            {EvKey, KeyCameraZoomin},
+	"KEY_CAMERA_ZOOMOUT":           {EvKey, KeyCameraZoomout},
+	"KEY_CAMERA_UP":                {EvKey, KeyCameraUp},
+	"KEY_CAMERA_DOWN":              {EvKey, KeyCameraDown},
+	"KEY_CAMERA_LEFT":              {EvKey, KeyCameraLeft},
+	"KEY_CAMERA_RIGHT":             {EvKey, KeyCameraRight},
+	"KEY_ATTENDANT_ON":             {EvKey, KeyAttendantOn},
+	"KEY_ATTENDANT_OFF":            {EvKey, KeyAttendantOff},
+	"KEY_ATTENDANT_TOGGLE":         {EvKey, KeyAttendantToggle},
+	"KEY_LIGHTS_TOGGLE":            {EvKey, KeyLightsToggle},
+	"BTN_DPAD_UP":                  {EvKey, BtnDpadUp},
+	"BTN_DPAD_DOWN":                {EvKey, BtnDpadDown},
+	"BTN_DPAD_LEFT":                {EvKey, BtnDpadLeft},
+	"BTN_DPAD_RIGHT":               {EvKey, BtnDpadRight},
+	"KEY_ALS_TOGGLE":               {EvKey, KeyAlsToggle},
+	"KEY_ROTATE_LOCK_TOGGLE":       {EvKey, KeyRotateLockToggle},
+	"KEY_REFRESH_RATE_TOGGLE":      {EvKey, KeyRefreshRateToggle},
+	"KEY_BUTTONCONFIG":             {EvKey, KeyButtonconfig},
+	"KEY_TASKMANAGER":              {EvKey, KeyTaskmanager},
+	"KEY_JOURNAL":                  {EvKey, KeyJournal},
+	"KEY_CONTROLPANEL":             {EvKey, KeyControlpanel},
+	"KEY_APPSELECT":                {EvKey, KeyAppselect},
+	"KEY_SCREENSAVER":              {EvKey, KeyScreensaver},
+	"KEY_VOICECOMMAND":             {EvKey, KeyVoicecommand},
+	"KEY_ASSISTANT":                {EvKey, KeyAssistant},
+	"KEY_KBD_LAYOUT_NEXT":          {EvKey, KeyKbdLayoutNext},
+	"KEY_EMOJI_PICKER":             {EvKey, KeyEmojiPicker},
+	"KEY_DICTATE":                  {EvKey, KeyDictate},
+	"KEY_BRIGHTNESS_MIN":           {EvKey, KeyBrightnessMin},
+	"KEY_BRIGHTNESS_MAX":           {EvKey, KeyBrightnessMax},
+	"KEY_KBDINPUTASSIST_PREV":      {EvKey, KeyKbdinputassistPrev},
+	"KEY_KBDINPUTASSIST_NEXT":      {EvKey, KeyKbdinputassistNext},
+	"KEY_KBDINPUTASSIST_PREVGROUP": {EvKey, KeyKbdinputassistPrevgroup},
+	"KEY_KBDINPUTASSIST_NEXTGROUP": {EvKey, KeyKbdinputassistNextgroup},
+	"KEY_KBDINPUTASSIST_ACCEPT":    {EvKey, KeyKbdinputassistAccept},
+	"KEY_KBDINPUTASSIST_CANCEL":    {EvKey, KeyKbdinputassistCancel},
+	"KEY_RIGHT_UP":                 {EvKey, KeyRightUp},
+	"KEY_RIGHT_DOWN":               {EvKey, KeyRightDown},
+	"KEY_LEFT_UP":                  {EvKey, KeyLeftUp},
+	"KEY_LEFT_DOWN":                {EvKey, KeyLeftDown},
+	"KEY_ROOT_MENU":                {EvKey, KeyRootMenu},
+	"KEY_MEDIA_TOP_MENU":           {EvKey, KeyMediaTopMenu},
+	"KEY_NUMERIC_11":               {EvKey, KeyNumeric11},
+	"KEY_NUMERIC_12":               {EvKey, KeyNumeric12},
+	"KEY_AUDIO_DESC":               {EvKey, KeyAudioDesc},
+	"KEY_3D_MODE":                  {EvKey, Key3DMode},
+	"KEY_NEXT_FAVORITE":            {EvKey, KeyNextFavorite},
+	"KEY_STOP_RECORD":              {EvKey, KeyStopRecord},
+	"KEY_PAUSE_RECORD":             {EvKey, KeyPauseRecord},
+	"KEY_VOD":                      {EvKey, KeyVod},
+	"KEY_UNMUTE":                   {EvKey, KeyUnmute},
+	"KEY_FASTREVERSE":              {EvKey, KeyFastreverse},
+	"KEY_SLOWREVERSE":              {EvKey, KeySlowreverse},
+	"KEY_DATA":                     {EvKey, KeyData},
+	"KEY_ONSCREEN_KEYBOARD":        {EvKey, KeyOnscreenKeyboard},
+	"KEY_PRIVACY_SCREEN_TOGGLE":    {EvKey, KeyPrivacyScreenToggle},
+	"KEY_SELECTIVE_SCREENSHOT":     {EvKey, KeySelectiveScreenshot},
+	"KEY_NEXT_ELEMENT":             {EvKey, KeyNextElement},
+	"KEY_PREVIOUS_ELEMENT":         {EvKey, KeyPreviousElement},
+	"KEY_AUTOPILOT_ENGAGE_TOGGLE":  {EvKey, KeyAutopilotEngageToggle},
+	"KEY_MARK_WAYPOINT":            {EvKey, KeyMarkWaypoint},
+	"KEY_SOS":                      {EvKey, KeySos},
+	"KEY_NAV_CHART":                {EvKey, KeyNavChart},
+	"KEY_FISHING_CHART":            {EvKey, KeyFishingChart},
+	"KEY_SINGLE_RANGE_RADAR":       {EvKey, KeySingleRangeRadar},
+	"KEY_DUAL_RANGE_RADAR":         {EvKey, KeyDualRangeRadar},
+	"KEY_RADAR_OVERLAY":            {EvKey, KeyRadarOverlay},
+	"KEY_TRADITIONAL_SONAR":        {EvKey, KeyTraditionalSonar},
+	"KEY_CLEARVU_SONAR":            {EvKey, KeyClearvuSonar},
+	"KEY_SIDEVU_SONAR":             {EvKey, KeySidevuSonar},
+	"KEY_NAV_INFO":                 {EvKey, KeyNavInfo},
+	"KEY_BRIGHTNESS_MENU":          {EvKey, KeyBrightnessMenu},
+	"KEY_MACRO1":                   {EvKey, KeyMacro1},
+	"KEY_MACRO2":                   {EvKey, KeyMacro2},
+	"KEY_MACRO3":                   {EvKey, KeyMacro3},
+	"KEY_MACRO4":                   {EvKey, KeyMacro4},
+	"KEY_MACRO5":                   {EvKey, KeyMacro5},
+	"KEY_MACRO6":                   {EvKey, KeyMacro6},
+	"KEY_MACRO7":                   {EvKey, KeyMacro7},
+	"KEY_MACRO8":                   {EvKey, KeyMacro8},
+	"KEY_MACRO9":                   {EvKey, KeyMacro9},
+	"KEY_MACRO10":                  {EvKey, KeyMacro10},
+	"KEY_MACRO11":                  {EvKey, KeyMacro11},
+	"KEY_MACRO12":                  {EvKey, KeyMacro12},
+	"KEY_MACRO13":                  {EvKey, KeyMacro13},
+	"KEY_MACRO14":                  {EvKey, KeyMacro14},
+	"KEY_MACRO15":                  {EvKey, KeyMacro15},
+	"KEY_MACRO16":                  {EvKey, KeyMacro16},
+	"KEY_MACRO17":                  {EvKey, KeyMacro17},
+	"KEY_MACRO18":                  {EvKey, KeyMacro18},
+	"KEY_MACRO19":                  {EvKey, KeyMacro19},
+	"KEY_MACRO20":                  {EvKey, KeyMacro20},
+	"KEY_MACRO21":                  {EvKey, KeyMacro21},
+	"KEY_MACRO22":                  {EvKey, KeyMacro22},
+	"KEY_MACRO23":                  {EvKey, KeyMacro23},
+	"KEY_MACRO24":                  {EvKey, KeyMacro24},
+	"KEY_MACRO25":                  {EvKey, KeyMacro25},
+	"KEY_MACRO26":                  {EvKey, KeyMacro26},
+	"KEY_MACRO27":                  {EvKey, KeyMacro27},
+	"KEY_MACRO28":                  {EvKey, KeyMacro28},
+	"KEY_MACRO29":                  {EvKey, KeyMacro29},
+	"KEY_MACRO30":                  {EvKey, KeyMacro30},
+	"KEY_MACRO_RECORD_START":       {EvKey, KeyMacroRecordStart},
+	"KEY_MACRO_RECORD_STOP":        {EvKey, KeyMacroRecordStop},
+	"KEY_MACRO_PRESET_CYCLE":       {EvKey, KeyMacroPresetCycle},
+	"KEY_MACRO_PRESET1":            {EvKey, KeyMacroPreset1},
+	"KEY_MACRO_PRESET2":            {EvKey, KeyMacroPreset2},
+	"KEY_MACRO_PRESET3":            {EvKey, KeyMacroPreset3},
+	"KEY_KBD_LCD_MENU1":            {EvKey, KeyKbdLcdMenu1},
+	"KEY_KBD_LCD_MENU2":            {EvKey, KeyKbdLcdMenu2},
+	"KEY_KBD_LCD_MENU3":            {EvKey, KeyKbdLcdMenu3},
+	"KEY_KBD_LCD_MENU4":            {EvKey, KeyKbdLcdMenu4},
+	"KEY_KBD_LCD_MENU5":            {EvKey, KeyKbdLcdMenu5},
+	"BTN_TRIGGER_HAPPY":            {EvKey, BtnTriggerHappy},
+	"BTN_TRIGGER_HAPPY1":           {EvKey, BtnTriggerHappy1},
+	"BTN_TRIGGER_HAPPY2":           {EvKey, BtnTriggerHappy2},
+	"BTN_TRIGGER_HAPPY3":           {EvKey, BtnTriggerHappy3},
+	"BTN_TRIGGER_HAPPY4":           {EvKey, BtnTriggerHappy4},
+	"BTN_TRIGGER_HAPPY5":           {EvKey, BtnTriggerHappy5},
+	"BTN_TRIGGER_HAPPY6":           {EvKey, BtnTriggerHappy6},
+	"BTN_TRIGGER_HAPPY7":           {EvKey, BtnTriggerHappy7},
+	"BTN_TRIGGER_HAPPY8":           {EvKey, BtnTriggerHappy8},
+	"BTN_TRIGGER_HAPPY9":           {EvKey, BtnTriggerHappy9},
+	"BTN_TRIGGER_HAPPY10":          {EvKey, BtnTriggerHappy10},
+	"BTN_TRIGGER_HAPPY11":          {EvKey, BtnTriggerHappy11},
+	"BTN_TRIGGER_HAPPY12":          {EvKey, BtnTriggerHappy12},
+	"BTN_TRIGGER_HAPPY13":          {EvKey, BtnTriggerHappy13},
+	"BTN_TRIGGER_HAPPY14":          {EvKey, BtnTriggerHappy14},
+	"BTN_TRIGGER_HAPPY15":          {EvKey, BtnTriggerHappy15},
+	"BTN_TRIGGER_HAPPY16":          {EvKey, BtnTriggerHappy16},
+	"BTN_TRIGGER_HAPPY17":          {EvKey, BtnTriggerHappy17},
+	"BTN_TRIGGER_HAPPY18":          {EvKey, BtnTriggerHappy18},
+	"BTN_TRIGGER_HAPPY19":          {EvKey, BtnTriggerHappy19},
+	"BTN_TRIGGER_HAPPY20":          {EvKey, BtnTriggerHappy20},
+	"BTN_TRIGGER_HAPPY21":          {EvKey, BtnTriggerHappy21},
+	"BTN_TRIGGER_HAPPY22":          {EvKey, BtnTriggerHappy22},
+	"BTN_TRIGGER_HAPPY23":          {EvKey, BtnTriggerHappy23},
+	"BTN_TRIGGER_HAPPY24":          {EvKey, BtnTriggerHappy24},
+	"BTN_TRIGGER_HAPPY25":          {EvKey, BtnTriggerHappy25},
+	"BTN_TRIGGER_HAPPY26":          {EvKey, BtnTriggerHappy26},
+	"BTN_TRIGGER_HAPPY27":          {EvKey, BtnTriggerHappy27},
+	"BTN_TRIGGER_HAPPY28":          {EvKey, BtnTriggerHappy28},
+	"BTN_TRIGGER_HAPPY29":          {EvKey, BtnTriggerHappy29},
+	"BTN_TRIGGER_HAPPY30":          {EvKey, BtnTriggerHappy30},
+	"BTN_TRIGGER_HAPPY31":          {EvKey, BtnTriggerHappy31},
+	"BTN_TRIGGER_HAPPY32":          {EvKey, BtnTriggerHappy32},
+	"BTN_TRIGGER_HAPPY33":          {EvKey, BtnTriggerHappy33},
+	"BTN_TRIGGER_HAPPY34":          {EvKey, BtnTriggerHappy34},
+	"BTN_TRIGGER_HAPPY35":          {EvKey, BtnTriggerHappy35},
+	"BTN_TRIGGER_HAPPY36":          {EvKey, BtnTriggerHappy36},
+	"BTN_TRIGGER_HAPPY37":          {EvKey, BtnTriggerHappy37},
+	"BTN_TRIGGER_HAPPY38":          {EvKey, BtnTriggerHappy38},
+	"BTN_TRIGGER_HAPPY39":          {EvKey, BtnTriggerHappy39},
+	"BTN_TRIGGER_HAPPY40":          {EvKey, BtnTriggerHappy40},
+	"KEY_MIN_INTERESTING":          {EvKey, KeyMinInteresting},
+	"REL_X":                        {EvRel, RelX},
+	"REL_Y":                        {EvRel, RelY},
+	"REL_Z":                        {EvRel, RelZ},
+	"REL_RX":                       {EvRel, RelRx},
+	"REL_RY":                       {EvRel, RelRy},
+	"REL_RZ":                       {EvRel, RelRz},
+	"REL_HWHEEL":                   {EvRel, RelHwheel},
+	"REL_DIAL":                     {EvRel, RelDial},
+	"REL_WHEEL":                    {EvRel, RelWheel},
+	"REL_MISC":                     {EvRel, RelMisc},
+	"REL_RESERVED":                 {EvRel, RelReserved},
+	"REL_WHEEL_HI_RES":             {EvRel, RelWheelHiRes},
+	"REL_HWHEEL_HI_RES":            {EvRel, RelHwheelHiRes},
+	"ABS_X":                        {EvAbs, AbsX},
+	"ABS_Y":                        {EvAbs, AbsY},
+	"ABS_Z":                        {EvAbs, AbsZ},
+	"ABS_RX":                       {EvAbs, AbsRx},
+	"ABS_RY":                       {EvAbs, AbsRy},
+	"ABS_RZ":                       {EvAbs, AbsRz},
+	"ABS_THROTTLE":                 {EvAbs, AbsThrottle},
+	"ABS_RUDDER":                   {EvAbs, AbsRudder},
+	"ABS_WHEEL":                    {EvAbs, AbsWheel},
+	"ABS_GAS":                      {EvAbs, AbsGas},
+	"ABS_BRAKE":                    {EvAbs, AbsBrake},
+	"ABS_HAT0X":                    {EvAbs, AbsHat0X},
+	"ABS_HAT0Y":                    {EvAbs, AbsHat0Y},
+	"ABS_HAT1X":                    {EvAbs, AbsHat1X},
+	"ABS_HAT1Y":                    {EvAbs, AbsHat1Y},
+	"ABS_HAT2X":                    {EvAbs, AbsHat2X},
+	"ABS_HAT2Y":                    {EvAbs, AbsHat2Y},
+	"ABS_HAT3X":                    {EvAbs, AbsHat3X},
+	"ABS_HAT3Y":                    {EvAbs, AbsHat3Y},
+	"ABS_PRESSURE":                 {EvAbs, AbsPressure},
+	"ABS_DISTANCE":                 {EvAbs, AbsDistance},
+	"ABS_TILT_X":                   {EvAbs, AbsTiltX},
+	"ABS_TILT_Y":                   {EvAbs, AbsTiltY},
+	"ABS_TOOL_WIDTH":               {EvAbs, AbsToolWidth},
+	"ABS_VOLUME":                   {EvAbs, AbsVolume},
+	"ABS_PROFILE":                  {EvAbs, AbsProfile},
+	"ABS_MISC":                     {EvAbs, AbsMisc},
+	"ABS_RESERVED":                 {EvAbs, AbsReserved},
+	"ABS_MT_SLOT":                  {EvAbs, AbsMtSlot},
+	"ABS_MT_TOUCH_MAJOR":           {EvAbs, AbsMtTouchMajor},
+	"ABS_MT_TOUCH_MINOR":           {EvAbs, AbsMtTouchMinor},
+	"ABS_MT_WIDTH_MAJOR":           {EvAbs, AbsMtWidthMajor},
+	"ABS_MT_WIDTH_MINOR":           {EvAbs, AbsMtWidthMinor},
+	"ABS_MT_ORIENTATION":           {EvAbs, AbsMtOrientation},
+	"ABS_MT_POSITION_X":            {EvAbs, AbsMtPositionX},
+	"ABS_MT_POSITION_Y":            {EvAbs, AbsMtPositionY},
+	"ABS_MT_TOOL_TYPE":             {EvAbs, AbsMtToolType},
+	"ABS_MT_BLOB_ID":               {EvAbs, AbsMtBlobId},
+	"ABS_MT_TRACKING_ID":           {EvAbs, AbsMtTrackingId},
+	"ABS_MT_PRESSURE":              {EvAbs, AbsMtPressure},
+	"ABS_MT_DISTANCE":              {EvAbs, AbsMtDistance},
+	"ABS_MT_TOOL_X":                {EvAbs, AbsMtToolX},
+	"ABS_MT_TOOL_Y":                {EvAbs, AbsMtToolY},
+	"SW_LID":                       {EvSw, SwLid},
+	"SW_TABLET_MODE":               {EvSw, SwTabletMode},
+	"SW_HEADPHONE_INSERT":          {EvSw, SwHeadphoneInsert},
+	"SW_RFKILL_ALL":                {EvSw, SwRfkillAll},
+	"SW_RADIO":                     {EvSw, SwRadio},
+	"SW_MICROPHONE_INSERT":         {EvSw, SwMicrophoneInsert},
+	"SW_DOCK":                      {EvSw, SwDock},
+	"SW_LINEOUT_INSERT":            {EvSw, SwLineoutInsert},
+	"SW_JACK_PHYSICAL_INSERT":      {EvSw, SwJackPhysicalInsert},
+	"SW_VIDEOOUT_INSERT":           {EvSw, SwVideooutInsert},
+	"SW_CAMERA_LENS_COVER":         {EvSw, SwCameraLensCover},
+	"SW_KEYPAD_SLIDE":              {EvSw, SwKeypadSlide},
+	"SW_FRONT_PROXIMITY":           {EvSw, SwFrontProximity},
+	"SW_ROTATE_LOCK":               {EvSw, SwRotateLock},
+	"SW_LINEIN_INSERT":             {EvSw, SwLineinInsert},
+	"SW_MUTE_DEVICE":               {EvSw, SwMuteDevice},
+	"SW_PEN_INSERTED":              {EvSw, SwPenInserted},
+	"SW_MACHINE_COVER":             {EvSw, SwMachineCover},
+	"MSC_SERIAL":                   {EvMsc, MscSerial},
+	"MSC_PULSELED":                 {EvMsc, MscPulseled},
+	"MSC_GESTURE":                  {EvMsc, MscGesture},
+	"MSC_RAW":                      {EvMsc, MscRaw},
+	"MSC_SCAN":                     {EvMsc, MscScan},
+	"MSC_TIMESTAMP":                {EvMsc, MscTimestamp},
+	"LED_NUML":                     {EvLed, LedNuml},
+	"LED_CAPSL":                    {EvLed, LedCapsl},
+	"LED_SCROLLL":                  {EvLed, LedScrolll},
+	"LED_COMPOSE":                  {EvLed, LedCompose},
+	"LED_KANA":                     {EvLed, LedKana},
+	"LED_SLEEP":                    {EvLed, LedSleep},
+	"LED_SUSPEND":                  {EvLed, LedSuspend},
+	"LED_MUTE":                     {EvLed, LedMute},
+	"LED_MISC":                     {EvLed, LedMisc},
+	"LED_MAIL":                     {EvLed, LedMail},
+	"LED_CHARGING":                 {EvLed, LedCharging},
+	"REP_DELAY":                    {EvRep, RepDelay},
+	"REP_PERIOD":                   {EvRep, RepPeriod},
+	"SND_CLICK":                    {EvSnd, SndClick},
+	"SND_BELL":                     {EvSnd, SndBell},
+	"SND_TONE":                     {EvSnd, SndTone},
+	"FF_STATUS_STOPPED":            {EvFFStatus, FFStatusStopped},
+	"FF_STATUS_PLAYING":            {EvFFStatus, FFStatusPlaying},
+	"FF_RUMBLE":                    {EvFF, FFRumble},
+	"FF_PERIODIC":                  {EvFF, FFPeriodic},
+	"FF_CONSTANT":                  {EvFF, FFConstant},
+	"FF_SPRING":                    {EvFF, FFSpring},
+	"FF_FRICTION":                  {EvFF, FFFriction},
+	"FF_DAMPER":                    {EvFF, FFDamper},
+	"FF_INERTIA":                   {EvFF, FFInertia},
+	"FF_RAMP":                      {EvFF, FFRamp},
+	"FF_EFFECT_MIN":                {EvFF, FFEffectMin},
+	"FF_EFFECT_MAX":                {EvFF, FFEffectMax},
+	"FF_SQUARE":                    {EvFF, FFSquare},
+	"FF_TRIANGLE":                  {EvFF, FFTriangle},
+	"FF_SINE":                      {EvFF, FFSine},
+	"FF_SAW_UP":                    {EvFF, FFSawUp},
+	"FF_SAW_DOWN":                  {EvFF, FFSawDown},
+	"FF_CUSTOM":                    {EvFF, FFCustom},
+	"FF_WAVEFORM_MIN":              {EvFF, FFWaveformMin},
+	"FF_WAVEFORM_MAX":              {EvFF, FFWaveformMax},
+	"FF_GAIN":                      {EvFF, FFGain},
+	"FF_AUTOCENTER":                {EvFF, FFAutocenter},
+	"FF_MAX_EFFECTS":               {EvFF, FFMaxEffects},
+}
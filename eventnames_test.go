@@ -0,0 +1,141 @@
+package uinput
+
+import (
+	"testing"
+)
+
+func TestEventCodeString(t *testing.T) {
+	for _, tc := range []struct {
+		code     EventCode
+		expected string
+	}{
+		{EventCode{EvKey, KeyA}, "KEY_A"},
+		{EventCode{EvKey, ButtonSouth}, "BTN_SOUTH"},
+		{EventCode{EvKey, BtnLeft}, "BTN_LEFT"},
+		{EventCode{EvKey, KeyHangeul}, "KEY_HANGEUL"},
+		{EventCode{EvRel, RelWheel}, "REL_WHEEL"},
+		{EventCode{EvAbs, AbsMtTrackingId}, "ABS_MT_TRACKING_ID"},
+		{EventCode{EvFF, FFRumble}, "FF_RUMBLE"},
+		{EventCode{EvSyn, SynReport}, "SYN_REPORT"},
+		{EventCode{EvKey, 0x2fe}, "EV_KEY:0x2fe"},
+	} {
+		if tc.code.String() != tc.expected {
+			t.Fatalf("Expected: %s\nActual: %s", tc.expected, tc.code)
+		}
+	}
+}
+
+func TestEventTypeString(t *testing.T) {
+	if EventType(EvAbs).String() != "EV_ABS" {
+		t.Fatalf("Expected: EV_ABS\nActual: %s", EventType(EvAbs))
+	}
+	if EventType(0x1e).String() != "EV_0x1e" {
+		t.Fatalf("Expected: EV_0x1e\nActual: %s", EventType(0x1e))
+	}
+}
+
+func TestParseEventCodeAcceptsKernelAndGoNames(t *testing.T) {
+	for name, expected := range map[string]EventCode{
+		"KEY_A":         {EvKey, KeyA},
+		"KeyA":          {EvKey, KeyA},
+		"KEY_LEFTCTRL":  {EvKey, KeyLeftctrl},
+		"KeyLeftctrl":   {EvKey, KeyLeftctrl},
+		"KeyVideoNext":  {EvKey, KeyVideoNext},
+		"Key102Nd":      {EvKey, Key102Nd},
+		"BTN_A":         {EvKey, ButtonSouth},
+		"ButtonSouth":   {EvKey, ButtonSouth},
+		"ButtonMode":    {EvKey, ButtonMode},
+		"REL_HWHEEL":    {EvRel, RelHwheel},
+		"ABS_HAT0X":     {EvAbs, AbsHat0X},
+		"FFRumble":      {EvFF, FFRumble},
+		"SW_LID":        {EvSw, 0x00},
+		"LED_CAPSL":     {EvLed, 0x01},
+		"MSC_SCAN":      {EvMsc, 0x04},
+		"KEY_HANGUEL":   {EvKey, KeyHangeul},
+		"key_leftshift": {EvKey, KeyLeftshift},
+	} {
+		code, err := ParseEventCode(name)
+		if err != nil {
+			t.Fatalf("Failed to parse %s. Last error was: %s\n", name, err)
+		}
+		if code != expected {
+			t.Fatalf("Parsing %s\nExpected: %v\nActual: %v", name, expected, code)
+		}
+	}
+}
+
+func TestParseEventCodeFailsOnUnknownName(t *testing.T) {
+	_, err := ParseEventCode("KEY_DOES_NOT_EXIST")
+	if err == nil {
+		t.Fatalf("Expected parsing to fail, but got no error.")
+	}
+}
+
+func TestParseCodeChecksEventType(t *testing.T) {
+	_, err := ParseCode(EvRel, "KEY_A")
+	if err == nil {
+		t.Fatalf("Expected parsing a key as relative axis to fail, but got no error.")
+	}
+
+	code, err := ParseCode(EvAbs, "0x10")
+	if err != nil || code != AbsHat0X {
+		t.Fatalf("Expected: %d\nActual: %d (%v)", AbsHat0X, code, err)
+	}
+}
+
+func TestParseKeyRoundTrip(t *testing.T) {
+	for _, key := range []int{KeyEsc, KeyA, KeyMicmute, ButtonStart, ButtonDpadUp} {
+		parsed, err := ParseKey(KeyName(key))
+		if err != nil {
+			t.Fatalf("Failed to parse %s. Last error was: %s\n", KeyName(key), err)
+		}
+		if parsed != key {
+			t.Fatalf("Expected: %d\nActual: %d", key, parsed)
+		}
+	}
+}
+
+func TestParseEventType(t *testing.T) {
+	for _, name := range []string{"EV_ABS", "EvAbs"} {
+		evType, err := ParseEventType(name)
+		if err != nil || evType != EvAbs {
+			t.Fatalf("Expected: %d\nActual: %d (%v)", EvAbs, evType, err)
+		}
+	}
+}
+
+// Folding names must not make two different codes collide, otherwise a Go name
+// could silently resolve to the wrong code.
+func TestFoldedNamesAreUnique(t *testing.T) {
+	seen := map[string]string{}
+	for name, code := range eventCodesByName {
+		folded := foldName(name)
+		if other, ok := seen[folded]; ok && eventCodesByName[other] != code {
+			t.Fatalf("%s and %s fold to the same name %s", name, other, folded)
+		}
+		seen[folded] = name
+	}
+}
+
+func TestGeneratedCodesMatchKernelHeader(t *testing.T) {
+	for name, expected := range map[string]int{
+		"ABS_THROTTLE":       AbsThrottle,
+		"REL_WHEEL_HI_RES":   RelWheelHiRes,
+		"SW_LID":             SwLid,
+		"BTN_STYLUS":         BtnStylus,
+		"KEY_FN":             KeyFn,
+		"KEY_BRIGHTNESS_MAX": KeyBrightnessMax,
+	} {
+		code, err := ParseEventCode(name)
+		if err != nil {
+			t.Fatalf("Failed to parse %s. Last error was: %s\n", name, err)
+		}
+		if int(code.Code) != expected {
+			t.Fatalf("Parsing %s\nExpected: %#x\nActual: %#x", name, expected, code.Code)
+		}
+	}
+
+	if InputPropDirect != 0x01 || BusUsb != 0x03 || KeyMax != 0x2ff || AbsCnt != absSize {
+		t.Fatalf("Generated constants do not match input-event-codes.h")
+	}
+}
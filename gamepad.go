@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"io"
 	"os"
+	"time"
 )
 
 const MaximumAxisValue = 32767
@@ -33,12 +34,15 @@ type Gamepad interface {
 	ButtonPress(key int) error
 
 	// ButtonDown will send a button-press event to an existing gamepad device.
-	// The key can be any of the predefined keycodes from keycodes.go.
-	// Note that the key will be "held down" until "KeyUp" is called.
+	// The key can be any of the predefined keycodes from eventcodes.go.
+	// Note that the key will be "held down" until "KeyUp" is called. Nothing is sent if the
+	// button is already held down.
 	ButtonDown(key int) error
 
 	// ButtonUp will send a button-release event to an existing gamepad device.
-	// The key can be any of the predefined keycodes from keycodes.go.
+	// The key can be any of the predefined keycodes from eventcodes.go.
+	// Releasing a button that is not held down sends nothing and, depending on
+	// GamepadConfig.WarnOnUnpressedRelease, returns a *NotPressedError.
 	ButtonUp(key int) error
 
 	// LeftStickMoveX performs a movement of the left stick along the x-axis
@@ -66,6 +70,17 @@ type Gamepad interface {
   // RightTriggerForce performs a trigger-axis-rz event with a given force
   RightTriggerForce(value float32) error
 
+	// IsPressed reports whether the button is held down.
+	IsPressed(key int) bool
+	// Pressed returns the buttons that are held down, in ascending order.
+	Pressed() []int
+	// Snapshot returns the buttons held down and the current axis values.
+	Snapshot() GamepadState
+
+	// ReleaseAll will release every button and hat direction and center every stick and trigger
+	// in a single frame. Close does this as well before the device is destroyed.
+	ReleaseAll() error
+
 	io.Closer
 }
 
@@ -77,9 +92,32 @@ type GamepadWithRumble interface {
   ForceFeedbackCallback(callback func(upload *UInputFFUpload, erase *UInputFFErase) int32) error 
 }
 
+// GamepadConfig holds the settings of a gamepad created with CreateGamepadWithConfig.
+type GamepadConfig struct {
+	Vendor  uint16
+	Product uint16
+
+	// EffectsMax is the number of force-feedback effects the gamepad supports. If it is not zero,
+	// the gamepad supports rumble and implements GamepadWithRumble.
+	EffectsMax uint32
+
+	// WarnOnUnpressedRelease makes ButtonUp return a *NotPressedError for buttons that are not
+	// held down. Otherwise releasing such a button is a no-op.
+	WarnOnUnpressedRelease bool
+
+	// Axes sets the range, fuzz, flat and resolution of the sticks, triggers and hat (AbsX, AbsY,
+	// AbsZ, AbsRx, AbsRy, AbsRz, AbsHat0X and AbsHat0Y). The values from -1 to 1 passed to the
+	// gamepad and the hat positions are mapped onto the range, the center of the range is the rest
+	// position. Flat is the dead zone around the center of a stick.
+	Axes map[uint16]AbsInfo
+}
+
 type vGamepad struct {
 	name       []byte
 	deviceFile *os.File
+	state      *inputState
+	warnUp     bool
+	axes       map[uint16]AbsInfo
 }
 
 // CreateGamepad will create a new gamepad using the given uinput
@@ -94,12 +132,41 @@ func CreateGamepad(path string, name []byte, vendor uint16, product uint16) (Gam
 		return nil, err
 	}
 
-	fd, err := createVGamepadDevice(path, name, vendor, product, 0)
+	fd, err := createVGamepadDevice(path, name, vendor, product, 0, defaultGamepadAxes())
 	if err != nil {
 		return nil, err
 	}
 
-	return vGamepad{name: name, deviceFile: fd}, nil
+	return vGamepad{name: name, deviceFile: fd, state: newInputState()}, nil
+}
+
+// CreateGamepadWithConfig will create a new gamepad using the given uinput
+// device path of the uinput device and the given configuration.
+// If config.EffectsMax is not zero, the returned gamepad implements GamepadWithRumble.
+func CreateGamepadWithConfig(path string, name []byte, config GamepadConfig) (Gamepad, error) {
+	err := validateDevicePath(path)
+	if err != nil {
+		return nil, err
+	}
+	err = validateUinputName(name)
+	if err != nil {
+		return nil, err
+	}
+
+	axes, err := absAxes(defaultGamepadAxes(), config.Axes)
+	if err != nil {
+		return nil, err
+	}
+
+	fd, err := createVGamepadDevice(path, name, config.Vendor, config.Product, config.EffectsMax, axes)
+	if err != nil {
+		return nil, err
+	}
+
+	vg := vGamepad{name: name, deviceFile: fd, state: newInputState(), warnUp: config.WarnOnUnpressedRelease,
+		axes: config.Axes}
+	vg.setNeutralAxes()
+	return vg, nil
 }
 
 // CreateGamepadWithRumble will create a new gamepad using the given uinput 
@@ -119,20 +186,20 @@ func CreateGamepadWithRumble(path string, name []byte, vendor uint16, product ui
     return nil, fmt.Errorf("effectsMax is below the minimum value of 1, use CreateGamepad if you don't want rumble support")
   }
 
-	fd, err := createVGamepadDevice(path, name, vendor, product, effectsMax)
+	fd, err := createVGamepadDevice(path, name, vendor, product, effectsMax, defaultGamepadAxes())
 	if err != nil {
 		return nil, err
 	}
 
-	return vGamepad{name: name, deviceFile: fd}, nil
+	return vGamepad{name: name, deviceFile: fd, state: newInputState()}, nil
 }
 
 func (vg vGamepad) ButtonPress(key int) error {
-	err := vg.ButtonDown(key)
+	err := sendTrackedBtnEvent(vg.deviceFile, vg.state, key, btnStatePressed)
 	if err != nil {
 		return err
 	}
-	err = vg.ButtonUp(key)
+	err = sendTrackedBtnEvent(vg.deviceFile, vg.state, key, btnStateReleased)
 	if err != nil {
 		return err
 	}
@@ -140,51 +207,68 @@ func (vg vGamepad) ButtonPress(key int) error {
 }
 
 func (vg vGamepad) ButtonDown(key int) error {
-	return sendBtnEvent(vg.deviceFile, []int{key}, btnStatePressed)
+	_, err := changeTrackedBtnEvent(vg.deviceFile, vg.state, key, btnStatePressed)
+	return err
 }
 
 func (vg vGamepad) ButtonUp(key int) error {
-	return sendBtnEvent(vg.deviceFile, []int{key}, btnStateReleased)
+	released, err := changeTrackedBtnEvent(vg.deviceFile, vg.state, key, btnStateReleased)
+	if err != nil || released {
+		return err
+	}
+	return vg.state.releaseUnpressed(key, vg.warnUp)
+}
+
+func (vg vGamepad) IsPressed(key int) bool {
+	return vg.state.isPressed(key)
+}
+
+func (vg vGamepad) Pressed() []int {
+	return vg.state.pressedKeys()
+}
+
+func (vg vGamepad) Snapshot() GamepadState {
+	return GamepadState{Pressed: vg.state.pressedKeys(), Axes: vg.state.axisValues()}
 }
 
 func (vg vGamepad) LeftStickMoveX(value float32) error {
-	return vg.sendStickAxisEvent(absX, value)
+	return vg.sendStickAxisEvent(AbsX, value)
 }
 
 func (vg vGamepad) LeftStickMoveY(value float32) error {
-	return vg.sendStickAxisEvent(absY, value)
+	return vg.sendStickAxisEvent(AbsY, value)
 }
 
 func (vg vGamepad) RightStickMoveX(value float32) error {
-	return vg.sendStickAxisEvent(absRX, value)
+	return vg.sendStickAxisEvent(AbsRx, value)
 }
 
 func (vg vGamepad) RightStickMoveY(value float32) error {
-	return vg.sendStickAxisEvent(absRY, value)
+	return vg.sendStickAxisEvent(AbsRy, value)
 }
 
 func (vg vGamepad) LeftStickMove(x, y float32) error {
 	values := map[uint16]float32{}
-	values[absX] = x
-	values[absY] = y
+	values[AbsX] = x
+	values[AbsY] = y
 
 	return vg.sendStickEvent(values)
 }
 
 func (vg vGamepad) RightStickMove(x, y float32) error {
 	values := map[uint16]float32{}
-	values[absRX] = x
-	values[absRY] = y
+	values[AbsRx] = x
+	values[AbsRy] = y
 
 	return vg.sendStickEvent(values)
 }
 
 func (vg vGamepad) LeftTriggerForce(value float32) error {
-  return vg.sendStickAxisEvent(absZ, value)
+  return vg.sendStickAxisEvent(AbsZ, value)
 }
 
 func (vg vGamepad) RightTriggerForce(value float32) error {
-  return vg.sendStickAxisEvent(absRZ, value)
+  return vg.sendStickAxisEvent(AbsRz, value)
 }
 
 func (vg vGamepad) HatPress(direction HatDirection) error {
@@ -200,70 +284,69 @@ func (vg vGamepad) ForceFeedbackCallback(callback func(upload *UInputFFUpload, e
 }
 
 func (vg vGamepad) sendStickAxisEvent(absCode uint16, value float32) error {
+	vg.state.send.Lock()
+	defer vg.state.send.Unlock()
 	ev := inputEvent{
-		Type:  evAbs,
+		Type:  EvAbs,
 		Code:  absCode,
-		Value: denormalizeInput(value),
-	}
-
-	buf, err := inputEventToBuffer(ev)
-	if err != nil {
-		return fmt.Errorf("writing abs stick event failed: %v", err)
+		Value: vg.denormalize(absCode, value),
 	}
 
-	_, err = vg.deviceFile.Write(buf)
+	err := sendEvents(vg.deviceFile, []inputEvent{ev})
 	if err != nil {
 		return fmt.Errorf("failed to write abs stick event to device file: %v", err)
 	}
-
-	return syncEvents(vg.deviceFile)
+	vg.state.setAxis(absCode, ev.Value)
+	return nil
 }
 
 func (vg vGamepad) sendStickEvent(values map[uint16]float32) error {
+	vg.state.send.Lock()
+	defer vg.state.send.Unlock()
+	var events []inputEvent
 	for code, value := range values {
-		ev := inputEvent{
-			Type:  evAbs,
+		events = append(events, inputEvent{
+			Type:  EvAbs,
 			Code:  code,
-			Value: denormalizeInput(value),
-		}
-
-		buf, err := inputEventToBuffer(ev)
-		if err != nil {
-			return fmt.Errorf("writing abs stick event failed: %v", err)
-		}
-
-		_, err = vg.deviceFile.Write(buf)
-		if err != nil {
-			return fmt.Errorf("failed to write abs stick event to device file: %v", err)
-		}
+			Value: vg.denormalize(code, value),
+		})
 	}
 
-	return syncEvents(vg.deviceFile)
+	err := sendEvents(vg.deviceFile, events)
+	if err != nil {
+		return fmt.Errorf("failed to write abs stick event to device file: %v", err)
+	}
+	for _, ev := range events {
+		vg.state.setAxis(ev.Code, ev.Value)
+	}
+	return nil
 }
 
 func (vg vGamepad) sendHatEvent(direction HatDirection, action HatAction) error {
+	vg.state.send.Lock()
+	defer vg.state.send.Unlock()
 	var event uint16
 	var value int32
 
 	switch direction {
 	case HatUp:
 		{
-			event = absHat0Y
+			event = AbsHat0Y
 			value = -1
 		}
 	case HatDown:
 		{
-			event = absHat0Y
+			event = AbsHat0Y
 			value = 1
 		}
 	case HatLeft:
 		{
-			event = absHat0X
+			event = AbsHat0X
 			value = -1
 		}
 	case HatRight:
 		{
-			event = absHat0X
+			event = AbsHat0X
 			value = 1
 		}
 	default:
@@ -275,31 +358,49 @@ func (vg vGamepad) sendHatEvent(direction HatDirection, action HatAction) error
 	if action == Release {
 		value = 0
 	}
+	value = vg.hatValue(event, value)
 
 	ev := inputEvent{
-		Type:  evAbs,
+		Type:  EvAbs,
 		Code:  event,
 		Value: value,
 	}
 
-	buf, err := inputEventToBuffer(ev)
-	if err != nil {
-		return fmt.Errorf("writing abs stick event failed: %v", err)
-	}
-
-	_, err = vg.deviceFile.Write(buf)
+	err := sendEvents(vg.deviceFile, []inputEvent{ev})
 	if err != nil {
 		return fmt.Errorf("failed to write abs stick event to device file: %v", err)
 	}
+	vg.state.setAxis(event, value)
+	return nil
+}
 
-	return syncEvents(vg.deviceFile)
+// releaseTracked releases a button for the watchdog.
+func (vg vGamepad) releaseTracked(code int, since time.Time) (bool, error) {
+	return releaseTrackedBtnEvent(vg.deviceFile, vg.state, code, since)
+}
+
+func (vg vGamepad) trackedState() (*os.File, *inputState) {
+	return vg.deviceFile, vg.state
+}
+
+func (vg vGamepad) ReleaseAll() error {
+	return releaseAll(vg.deviceFile, vg.state)
 }
 
 func (vg vGamepad) Close() error {
-	return closeDevice(vg.deviceFile)
+	return releaseAndClose(vg.deviceFile, vg.state)
 }
 
-func createVGamepadDevice(path string, name []byte, vendor uint16, product uint16, effMax uint32) (fd *os.File, err error) {
+// defaultGamepadAxes returns the axes of a gamepad, all ranging from -MaximumAxisValue to MaximumAxisValue.
+func defaultGamepadAxes() map[uint16]AbsInfo {
+	axes := map[uint16]AbsInfo{}
+	for _, code := range []uint16{AbsX, AbsY, AbsZ, AbsRx, AbsRy, AbsRz, AbsHat0X, AbsHat0Y} {
+		axes[code] = AbsInfo{Min: -MaximumAxisValue, Max: MaximumAxisValue}
+	}
+	return axes
+}
+
+func createVGamepadDevice(path string, name []byte, vendor uint16, product uint16, effMax uint32, axes map[uint16]AbsInfo) (fd *os.File, err error) {
 	// This array is needed to register the event keys for the gamepad device.
 	keys := []uint16{
 		ButtonGamepad,
@@ -329,48 +430,27 @@ func createVGamepadDevice(path string, name []byte, vendor uint16, product uint1
 
 	// absEvents is for the absolute events for the gamepad device.
 	absEvents := []uint16{
-		absX,
-		absY,
-		absZ,
-		absRX,
-		absRY,
-		absRZ,
-		absHat0X,
-		absHat0Y,
+		AbsX,
+		AbsY,
+		AbsZ,
+		AbsRx,
+		AbsRy,
+		AbsRz,
+		AbsHat0X,
+		AbsHat0Y,
 	}
 
   ffEvents := []uint16{
     FFRumble,
   }
 
-  // tell uinput what the minimum/maximum abs value is
-  var absMin [absSize]int32
-  absMin[absX] = -MaximumAxisValue
-  absMin[absY] = -MaximumAxisValue
-  absMin[absZ] = -MaximumAxisValue
-  absMin[absRX] = -MaximumAxisValue
-  absMin[absRY] = -MaximumAxisValue
-  absMin[absRZ] = -MaximumAxisValue
-  absMin[absHat0X] = -MaximumAxisValue
-  absMin[absHat0Y] = -MaximumAxisValue
-
-  var absMax [absSize]int32
-  absMax[absX] = MaximumAxisValue
-  absMax[absY] = MaximumAxisValue
-  absMax[absZ] = MaximumAxisValue
-  absMax[absRX] = MaximumAxisValue
-  absMax[absRY] = MaximumAxisValue
-  absMax[absRZ] = MaximumAxisValue
-  absMax[absHat0X] = MaximumAxisValue
-  absMax[absHat0Y] = MaximumAxisValue
-
 	deviceFile, err := createDeviceFile(path)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create virtual gamepad device: %v", err)
 	}
 
 	// register button events
-	err = registerDevice(deviceFile, uintptr(evKey))
+	err = registerDevice(deviceFile, uintptr(EvKey))
 	if err != nil {
 		_ = deviceFile.Close()
 		return nil, fmt.Errorf("failed to register virtual gamepad device: %v", err)
@@ -385,7 +465,7 @@ func createVGamepadDevice(path string, name []byte, vendor uint16, product uint1
 	}
 
 	// register absolute events
-	err = registerDevice(deviceFile, uintptr(evAbs))
+	err = registerDevice(deviceFile, uintptr(EvAbs))
 	if err != nil {
 		_ = deviceFile.Close()
 		return nil, fmt.Errorf("failed to register absolute event input device: %v", err)
@@ -401,7 +481,7 @@ func createVGamepadDevice(path string, name []byte, vendor uint16, product uint1
 
   // register force-feedback events
   if effMax > 0 {
-    err = registerDevice(deviceFile, uintptr(evFF))
+    err = registerDevice(deviceFile, uintptr(EvFF))
     if err != nil {
       _ = deviceFile.Close()
       return nil, fmt.Errorf("failed to register ff event input device: %v", err)
@@ -415,19 +495,45 @@ func createVGamepadDevice(path string, name []byte, vendor uint16, product uint1
     }
   }
 
-	return createUsbDevice(deviceFile,
+	return createAbsDevice(deviceFile,
 		uinputUserDev{
 			Name: toUinputName(name),
 			ID: inputID{
-				Bustype: busUsb,
+				Bustype: BusUsb,
 				Vendor:  vendor,
 				Product: product,
 				Version: 1,
       },
       EffectsMax: effMax,
-      Absmin: absMin,
-      Absmax: absMax,
-    })
+    },
+    axes)
+}
+
+// setNeutralAxes records the center of the configured ranges as the value the axes are reset to on
+// release.
+func (vg vGamepad) setNeutralAxes() {
+	for code := range vg.axes {
+		if center := vg.denormalize(code, 0); center != 0 {
+			vg.state.setNeutral(code, center)
+		}
+	}
+}
+
+// denormalize maps a value from -1 to 1 onto the range of the axis.
+func (vg vGamepad) denormalize(code uint16, value float32) int32 {
+	if info, ok := vg.axes[code]; ok && (info.Min != 0 || info.Max != 0) {
+		return denormalizeAxis(info, value)
+	}
+	return denormalizeInput(value)
+}
+
+// hatValue maps a hat position (-1, 0 or 1) onto the configured range of the hat axis, the
+// position is sent as is if no range is configured.
+func (vg vGamepad) hatValue(code uint16, position int32) int32 {
+	if info, ok := vg.axes[code]; ok && (info.Min != 0 || info.Max != 0) {
+		return denormalizeAxis(info, float32(position))
+	}
+	return position
 }
 
 // Takes in a normalized value (-1.0:1.0) and return an event value
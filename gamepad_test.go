@@ -385,3 +385,54 @@ func TestGamepadWith0EffectsMax(t *testing.T) {
 }
 
 //TODO to test if rumble is working we need to send a rumble event to the gamepad
+
+func TestGamepadTracksButtonsAndAxes(t *testing.T) {
+	file := createTestDeviceFile(t)
+	defer removeTestDeviceFile(file)
+	vg := vGamepad{name: []byte("Test Gamepad"), deviceFile: file, state: newInputState()}
+
+	for _, button := range []int{ButtonSouth, ButtonSouth, ButtonStart} {
+		if err := vg.ButtonDown(button); err != nil {
+			t.Fatalf("Failed to press button. Last error was: %s\n", err)
+		}
+	}
+	if err := vg.ButtonUp(ButtonStart); err != nil {
+		t.Fatalf("Failed to release button. Last error was: %s\n", err)
+	}
+	if err := vg.LeftStickMove(1, -0.5); err != nil {
+		t.Fatalf("Failed to move stick. Last error was: %s\n", err)
+	}
+	if err := vg.HatPress(HatLeft); err != nil {
+		t.Fatalf("Failed to press hat. Last error was: %s\n", err)
+	}
+
+	if !vg.IsPressed(ButtonSouth) || vg.IsPressed(ButtonStart) {
+		t.Fatalf("Expected only ButtonSouth to be pressed, got %v", vg.Pressed())
+	}
+	state := vg.Snapshot()
+	if len(state.Pressed) != 1 || state.Axes[AbsX] != MaximumAxisValue || state.Axes[AbsY] != -MaximumAxisValue/2 || state.Axes[AbsHat0X] != -1 {
+		t.Fatalf("Unexpected gamepad state: %+v", state)
+	}
+
+	// the second ButtonDown of ButtonSouth is not sent
+	var buttonEvents int
+	for _, ev := range readTestEvents(t, file) {
+		if ev.Type == EvKey {
+			buttonEvents++
+		}
+	}
+	if buttonEvents != 3 {
+		t.Fatalf("Expected 3 button events, got %d", buttonEvents)
+	}
+}
+
+func TestGamepadButtonUpOfUnpressedButton(t *testing.T) {
+	file := createTestDeviceFile(t)
+	defer removeTestDeviceFile(file)
+	vg := vGamepad{name: []byte("Test Gamepad"), deviceFile: file, state: newInputState(), warnUp: true}
+
+	err := vg.ButtonUp(ButtonSouth)
+	if _, ok := err.(*NotPressedError); !ok {
+		t.Fatalf("Expected a *NotPressedError, got: %v", err)
+	}
+}
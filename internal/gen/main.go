@@ -0,0 +1,412 @@
+// Command gen generates the exported event code constants and the event name
+// tables of the uinput package from the pinned copies of the kernel headers
+// that live next to this file (taken from Linux 6.1).
+//
+// Run it from the root of the repository (go generate does that for you):
+//
+//	go run ./internal/gen
+//
+// To refresh the tables, replace input-event-codes.h and input.h with the
+// versions from a newer kernel (include/uapi/linux/) and run it again.
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"flag"
+	"fmt"
+	"go/format"
+	"io/ioutil"
+	"log"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+var (
+	headerDir = flag.String("headers", "internal/gen", "directory containing the pinned kernel headers")
+	outDir    = flag.String("o", ".", "directory the generated files are written to")
+)
+
+const (
+	codesFile = "eventcodes.go"
+	namesFile = "eventnames_table.go"
+)
+
+// define is a single "#define NAME VALUE" line of a header.
+type define struct {
+	name  string
+	value uint32
+	// ref is set if the value was given as the name of another define.
+	ref string
+	// expr is set if the value was given as (OTHER+1).
+	expr    string
+	comment string
+}
+
+// eventPrefixes maps the prefix of a code name to the event type it belongs to.
+// Order matters, FF_STATUS_ has to be matched before FF_.
+var eventPrefixes = []struct {
+	prefix string
+	evType string
+}{
+	{"SYN_", "EV_SYN"},
+	{"KEY_", "EV_KEY"},
+	{"BTN_", "EV_KEY"},
+	{"REL_", "EV_REL"},
+	{"ABS_", "EV_ABS"},
+	{"MSC_", "EV_MSC"},
+	{"SW_", "EV_SW"},
+	{"LED_", "EV_LED"},
+	{"SND_", "EV_SND"},
+	{"REP_", "EV_REP"},
+	{"FF_STATUS_", "EV_FF_STATUS"},
+	{"FF_", "EV_FF"},
+}
+
+// constPrefixes maps the prefix of a define to the prefix of the generated Go
+// constant. Defines without a matching prefix are not exported.
+var constPrefixes = []struct {
+	prefix   string
+	goPrefix string
+}{
+	{"EV_", "Ev"},
+	{"SYN_", "Syn"},
+	{"KEY_", "Key"},
+	{"BTN_", "Btn"},
+	{"REL_", "Rel"},
+	{"ABS_", "Abs"},
+	{"MSC_", "Msc"},
+	{"SW_", "Sw"},
+	{"LED_", "Led"},
+	{"SND_", "Snd"},
+	{"REP_", "Rep"},
+	{"FF_STATUS_", "FFStatus"},
+	{"FF_", "FF"},
+	{"INPUT_PROP_", "InputProp"},
+	{"BUS_", "Bus"},
+}
+
+// constBlocks groups the generated constants, every block gets its own doc comment.
+var constBlocks = []struct {
+	prefixes []string
+	doc      string
+}{
+	{[]string{"INPUT_PROP_"}, "Device properties and quirks (INPUT_PROP_*)."},
+	{[]string{"EV_"}, "Event types (EV_*)."},
+	{[]string{"SYN_"}, "Synchronization events (SYN_*)."},
+	{[]string{"KEY_", "BTN_"}, "Keys and buttons (KEY_*, BTN_*)."},
+	{[]string{"REL_"}, "Relative axes (REL_*)."},
+	{[]string{"ABS_"}, "Absolute axes (ABS_*)."},
+	{[]string{"SW_"}, "Switch events (SW_*)."},
+	{[]string{"MSC_"}, "Misc events (MSC_*)."},
+	{[]string{"LED_"}, "LEDs (LED_*)."},
+	{[]string{"REP_"}, "Autorepeat values (REP_*)."},
+	{[]string{"SND_"}, "Sounds (SND_*)."},
+	{[]string{"FF_STATUS_"}, "Force-feedback status values (FF_STATUS_*)."},
+	{[]string{"FF_"}, "Force-feedback effect types, waveforms and properties (FF_*)."},
+	{[]string{"BUS_"}, "Bus types (BUS_*)."},
+}
+
+// rangeMarkers are defines that share their value with a real code but only
+// mark the start of a block. They can be parsed but are never used as the
+// display name of a code.
+var rangeMarkers = map[string]bool{
+	"KEY_MIN_INTERESTING": true,
+	"BTN_MISC":            true,
+	"BTN_MOUSE":           true,
+	"BTN_JOYSTICK":        true,
+	"BTN_GAMEPAD":         true,
+	"BTN_DIGI":            true,
+	"BTN_WHEEL":           true,
+	"BTN_TRIGGER_HAPPY":   true,
+	"FF_EFFECT_MIN":       true,
+	"FF_EFFECT_MAX":       true,
+	"FF_WAVEFORM_MIN":     true,
+	"FF_WAVEFORM_MAX":     true,
+	"FF_MAX_EFFECTS":      true,
+}
+
+var (
+	defineRe  = regexp.MustCompile(`^#define\s+([A-Z][A-Z0-9_]*)\s+(.+?)\s*(/\*(.*?)(\*/)?)?$`)
+	plusOneRe = regexp.MustCompile(`^\(\s*([A-Z][A-Z0-9_]*)\s*\+\s*1\s*\)$`)
+)
+
+func main() {
+	log.SetFlags(0)
+	log.SetPrefix("gen: ")
+	flag.Parse()
+
+	var defines []define
+	for _, header := range []string{"input-event-codes.h", "input.h"} {
+		d, err := parseHeader(filepath.Join(*headerDir, header))
+		if err != nil {
+			log.Fatal(err)
+		}
+		defines = append(defines, d...)
+	}
+
+	names := goNames(defines)
+	for file, src := range map[string][]byte{
+		codesFile: generateCodes(defines, names),
+		namesFile: generateNames(defines, names),
+	} {
+		src, err := format.Source(src)
+		if err != nil {
+			log.Fatalf("formatting %s: %v", file, err)
+		}
+		if err := ioutil.WriteFile(filepath.Join(*outDir, file), src, 0644); err != nil {
+			log.Fatal(err)
+		}
+	}
+}
+
+func parseHeader(path string) ([]define, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	values := map[string]uint32{}
+	var defines []define
+	s := bufio.NewScanner(f)
+	for s.Scan() {
+		m := defineRe.FindStringSubmatch(strings.TrimSpace(s.Text()))
+		if m == nil {
+			continue
+		}
+		name, raw := m[1], m[2]
+		d := define{name: name}
+		if m[5] != "" {
+			// comments spanning multiple lines are dropped
+			d.comment = strings.TrimSpace(m[4])
+		}
+		if v, err := strconv.ParseUint(raw, 0, 32); err == nil {
+			d.value = uint32(v)
+		} else if v, ok := values[raw]; ok {
+			d.value = v
+			d.ref = raw
+		} else if p := plusOneRe.FindStringSubmatch(raw); p != nil {
+			v, ok := values[p[1]]
+			if !ok {
+				return nil, fmt.Errorf("%s: %s refers to unknown define %s", path, name, p[1])
+			}
+			d.value = v + 1
+			d.expr = p[1]
+		} else {
+			// include guards, ioctl numbers and the like
+			continue
+		}
+		values[name] = d.value
+		defines = append(defines, d)
+	}
+	return defines, s.Err()
+}
+
+// goName turns a kernel name into the name of the exported Go constant, e.g.
+// KEY_LEFTCTRL -> KeyLeftctrl, ABS_MT_POSITION_X -> AbsMtPositionX, KEY_102ND -> Key102Nd.
+func goName(name string) (string, bool) {
+	for _, p := range constPrefixes {
+		if !strings.HasPrefix(name, p.prefix) {
+			continue
+		}
+		var b strings.Builder
+		b.WriteString(p.goPrefix)
+		for _, part := range strings.Split(strings.TrimPrefix(name, p.prefix), "_") {
+			if part == "FF" {
+				b.WriteString(part)
+				continue
+			}
+			afterDigit := false
+			for i, r := range strings.ToLower(part) {
+				if i == 0 || afterDigit {
+					r = unicode.ToUpper(r)
+				}
+				afterDigit = unicode.IsDigit(r)
+				b.WriteRune(r)
+			}
+		}
+		return b.String(), true
+	}
+	return "", false
+}
+
+// goNames maps every exported define to its Go constant name.
+func goNames(defines []define) map[string]string {
+	names := map[string]string{}
+	seen := map[string]string{}
+	for _, d := range defines {
+		if d.name == "EV_VERSION" {
+			continue
+		}
+		n, ok := goName(d.name)
+		if !ok {
+			continue
+		}
+		if other, ok := seen[n]; ok {
+			log.Fatalf("%s and %s both map to the Go name %s", d.name, other, n)
+		}
+		seen[n] = d.name
+		names[d.name] = n
+	}
+	return names
+}
+
+func blockOf(name string) int {
+	for i, block := range constBlocks {
+		for _, prefix := range block.prefixes {
+			if strings.HasPrefix(name, prefix) {
+				// FF_STATUS_ is its own block
+				if prefix == "FF_" && strings.HasPrefix(name, "FF_STATUS_") {
+					continue
+				}
+				return i
+			}
+		}
+	}
+	return -1
+}
+
+func generateCodes(defines []define, names map[string]string) []byte {
+	blocks := make([][]define, len(constBlocks))
+	for _, d := range defines {
+		if _, ok := names[d.name]; !ok {
+			continue
+		}
+		if i := blockOf(d.name); i >= 0 {
+			blocks[i] = append(blocks[i], d)
+		}
+	}
+
+	var b bytes.Buffer
+	fmt.Fprintf(&b, "// Code generated by go run ./internal/gen; DO NOT EDIT.\n\n")
+	fmt.Fprintf(&b, "package uinput\n\n")
+	fmt.Fprintf(&b, "// The constants in this file relate 1:1 to the definitions in input-event-codes.h and input.h.\n")
+	fmt.Fprintf(&b, "// Names follow the kernel names, e.g. KEY_LEFTCTRL is KeyLeftctrl and ABS_MT_SLOT is AbsMtSlot.\n\n")
+	for i, block := range blocks {
+		fmt.Fprintf(&b, "// %s\n", constBlocks[i].doc)
+		fmt.Fprintf(&b, "const (\n")
+		for _, d := range block {
+			var value string
+			switch {
+			case d.ref != "":
+				value = names[d.ref]
+			case d.expr != "":
+				value = names[d.expr] + " + 1"
+			default:
+				value = fmt.Sprintf("%#02x", d.value)
+			}
+			fmt.Fprintf(&b, "\t%s = %s", names[d.name], value)
+			if d.comment != "" {
+				fmt.Fprintf(&b, " // %s", d.comment)
+			}
+			fmt.Fprintf(&b, "\n")
+		}
+		fmt.Fprintf(&b, ")\n\n")
+	}
+	return b.Bytes()
+}
+
+func eventTypeOf(name string) (string, bool) {
+	for _, p := range eventPrefixes {
+		if strings.HasPrefix(name, p.prefix) {
+			return p.evType, true
+		}
+	}
+	return "", false
+}
+
+// isLimit reports whether the define is the _MAX or _CNT entry of its block
+// (KEY_MAX, but not KEY_BRIGHTNESS_MAX).
+func isLimit(name string) bool {
+	for _, suffix := range []string{"MAX", "CNT"} {
+		if !strings.HasSuffix(name, suffix) {
+			continue
+		}
+		block := strings.TrimSuffix(name, suffix)
+		for _, p := range constPrefixes {
+			if p.prefix == block {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func generateNames(defines []define, names map[string]string) []byte {
+	types := map[string]uint32{}
+	var typeNames []string
+	for _, d := range defines {
+		if strings.HasPrefix(d.name, "EV_") && !isLimit(d.name) && d.name != "EV_VERSION" {
+			types[d.name] = d.value
+			typeNames = append(typeNames, d.name)
+		}
+	}
+
+	// display names are the first non marker define of every code
+	display := map[string]map[uint32]string{}
+	var all []define
+	allTypes := map[string]string{}
+	for _, d := range defines {
+		evType, ok := eventTypeOf(d.name)
+		if !ok || isLimit(d.name) {
+			continue
+		}
+		all = append(all, d)
+		allTypes[d.name] = evType
+		if rangeMarkers[d.name] || d.ref != "" {
+			continue
+		}
+		if display[evType] == nil {
+			display[evType] = map[uint32]string{}
+		}
+		if _, ok := display[evType][d.value]; !ok {
+			display[evType][d.value] = d.name
+		}
+	}
+
+	var b bytes.Buffer
+	fmt.Fprintf(&b, "// Code generated by go run ./internal/gen; DO NOT EDIT.\n\n")
+	fmt.Fprintf(&b, "package uinput\n\n")
+
+	fmt.Fprintf(&b, "// eventTypeNames maps every event type to its name in input-event-codes.h.\n")
+	fmt.Fprintf(&b, "var eventTypeNames = map[EventType]string{\n")
+	for _, name := range typeNames {
+		fmt.Fprintf(&b, "\t%s: %q,\n", names[name], name)
+	}
+	fmt.Fprintf(&b, "}\n\n")
+
+	fmt.Fprintf(&b, "// eventCodeNames maps the codes of every event type to the name used to display them.\n")
+	fmt.Fprintf(&b, "var eventCodeNames = map[EventType]map[uint16]string{\n")
+	for _, evType := range typeNames {
+		codes := display[evType]
+		if len(codes) == 0 {
+			continue
+		}
+		values := make([]int, 0, len(codes))
+		for v := range codes {
+			values = append(values, int(v))
+		}
+		sort.Ints(values)
+		fmt.Fprintf(&b, "\t%s: {\n", names[evType])
+		for _, v := range values {
+			fmt.Fprintf(&b, "\t\t%s: %q,\n", names[codes[uint32(v)]], codes[uint32(v)])
+		}
+		fmt.Fprintf(&b, "\t},\n")
+	}
+	fmt.Fprintf(&b, "}\n\n")
+
+	fmt.Fprintf(&b, "// eventCodesByName maps every code name (including aliases) to its code.\n")
+	fmt.Fprintf(&b, "var eventCodesByName = map[string]EventCode{\n")
+	for _, d := range all {
+		fmt.Fprintf(&b, "\t%q: {%s, %s},\n", d.name, names[allTypes[d.name]], names[d.name])
+	}
+	fmt.Fprintf(&b, "}\n")
+
+	return b.Bytes()
+}
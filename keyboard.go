@@ -4,6 +4,10 @@ import (
 	"fmt"
 	"io"
 	"os"
+	"sort"
+	"strings"
+	"syscall"
+	"time"
 )
 
 // A Keyboard is an key event output device. It is used to
@@ -13,28 +17,130 @@ type Keyboard interface {
 	KeyPress(key int) error
 
 	// KeyDown will send a keypress event to an existing keyboard device.
-	// The key can be any of the predefined keycodes from keycodes.go.
-	// Note that the key will be "held down" until "KeyUp" is called.
+	// The key can be any of the keys the keyboard was created with (see eventcodes.go).
+	// Note that the key will be "held down" until "KeyUp" is called. Nothing is sent if the key
+	// is already held down.
 	KeyDown(key int) error
 
 	// KeyUp will send a keyrelease event to an existing keyboard device.
-	// The key can be any of the predefined keycodes from keycodes.go.
+	// The key can be any of the keys the keyboard was created with (see eventcodes.go).
+	// Releasing a key that is not held down sends nothing and, depending on
+	// KeyboardConfig.WarnOnUnpressedRelease, returns a *NotPressedError.
 	KeyUp(key int) error
 
+	// KeyHold will press the key, hold it down for the given duration and release it. If the keyboard
+	// was created with KeyboardConfig.Repeat, the device generates repeat events while the key is held.
+	KeyHold(key int, duration time.Duration) error
+
+	// KeyRepeat will send a single repeat event (value 2) for a key that is held down.
+	KeyRepeat(key int) error
+
+	// TypeString will type the given text using the layout of the keyboard (see KeyboardConfig.Layout).
+	// Characters the layout can not produce are typed using the unicode fallbacks of the keyboard.
+	// If some of the characters can not be typed at all, an *UnsupportedRunesError is returned
+	// and nothing is typed. Custom fallbacks (UnicodeHook) can't be checked up front: if one of them
+	// fails, the text typed before stays typed.
+	TypeString(text string) error
+
+	// KeyChord will press the keys in the given order and release them in reverse order, e.g.
+	// KeyChord(KeyLeftctrl, KeyLeftalt, KeyT). Keys that were pressed are released even if sending
+	// one of the events fails.
+	KeyChord(keys ...int) error
+
+	// PressShortcut will perform a shortcut like "ctrl+shift+esc" or "super+1, 200ms, enter"
+	// (see ParseShortcut). The whole shortcut is parsed before anything is sent.
+	PressShortcut(shortcut ...string) error
+
+	// IsPressed reports whether the key is held down.
+	IsPressed(key int) bool
+
+	// Pressed returns the keys that are held down, in ascending order.
+	Pressed() []int
+
+	// Snapshot returns the current state of the keyboard.
+	Snapshot() KeyboardState
+
+	// ReleaseAll will release every key that is held down in a single frame.
+	// Close does this as well before the device is destroyed.
+	ReleaseAll() error
+
 	// FetchSysPath will return the syspath to the device file.
 	FetchSyspath() (string, error)
 
 	io.Closer
 }
 
+// KeyboardConfig holds the settings of a keyboard created with CreateKeyboardWithConfig.
+// The zero value describes the same keyboard CreateKeyboard creates.
+type KeyboardConfig struct {
+	// Keys is the exact set of keys the keyboard advertises. Only these keys can be sent.
+	// If Keys is empty, DefaultKeyboardKeys is used.
+	Keys []int
+
+	// Layout is the keyboard layout the system uses for the keyboard. It is used by TypeString
+	// to find the keys for a character. Defaults to LayoutUS.
+	Layout *Layout
+
+	// UnicodeFallbacks are used by TypeString for characters the layout can not produce, e.g.
+	// UnicodeHexInput or ComposeInput. Every character is typed using the first fallback that can type it.
+	UnicodeFallbacks []UnicodeFallback
+
+	// CapsLock is the state of Caps Lock when the keyboard is created. The keyboard keeps track of
+	// the Caps Lock presses it sends itself.
+	CapsLock bool
+
+	// Repeat makes the keyboard advertise autorepeat (EV_REP). Keys held down are then repeated
+	// by the device after RepeatDelay every RepeatPeriod, like on a physical keyboard.
+	Repeat bool
+
+	// RepeatDelay is the time a key has to be held down before it is repeated. Defaults to 250ms.
+	RepeatDelay time.Duration
+
+	// RepeatPeriod is the time between two repeat events. Defaults to 33ms.
+	RepeatPeriod time.Duration
+
+	// Scancodes makes the keyboard send a scancode (EV_MSC/MSC_SCAN) before every key press and
+	// release, like physical keyboards do. Keys without a scancode are sent without one.
+	// See UsbScancodes for the scancodes of a USB keyboard.
+	Scancodes map[int]int32
+
+	// Cadence makes the keyboard type with human-like timing. If nil, every key is pressed and
+	// released immediately.
+	Cadence *TypingCadence
+
+	// WarnOnUnpressedRelease makes KeyUp return a *NotPressedError for keys that are not held down.
+	// Otherwise releasing such a key is a no-op.
+	WarnOnUnpressedRelease bool
+}
+
+// default autorepeat settings, the same the kernel uses
+const (
+	defaultRepeatDelay  = 250 * time.Millisecond
+	defaultRepeatPeriod = 33 * time.Millisecond
+)
+
 type vKeyboard struct {
 	name       []byte
 	deviceFile *os.File
+	keys       map[int]bool
+	layout     *Layout
+	fallbacks  []UnicodeFallback
+	capsLock   bool
+	scancodes  map[int]int32
+	timer      *typingTimer
+	state      *inputState
+	warnUp     bool
 }
 
 // CreateKeyboard will create a new keyboard using the given uinput
-// device path of the uinput device.
+// device path of the uinput device. The keyboard advertises every key returned by DefaultKeyboardKeys.
 func CreateKeyboard(path string, name []byte) (Keyboard, error) {
+	return CreateKeyboardWithConfig(path, name, KeyboardConfig{})
+}
+
+// CreateKeyboardWithConfig will create a new keyboard using the given uinput
+// device path of the uinput device and the given configuration.
+func CreateKeyboardWithConfig(path string, name []byte, config KeyboardConfig) (Keyboard, error) {
 	err := validateDevicePath(path)
 	if err != nil {
 		return nil, err
@@ -44,72 +150,437 @@ func CreateKeyboard(path string, name []byte) (Keyboard, error) {
 		return nil, err
 	}
 
-	fd, err := createVKeyboardDevice(path, name)
+	keys := config.Keys
+	if len(keys) == 0 {
+		keys = DefaultKeyboardKeys()
+	}
+	keySet := make(map[int]bool, len(keys))
+	for _, key := range keys {
+		if !keyCodeInRange(key) {
+			return nil, fmt.Errorf("failed to create keyboard. Code %d is not in range", key)
+		}
+		keySet[key] = true
+	}
+
+	var scancodes map[int]int32
+	if config.Scancodes != nil {
+		scancodes = make(map[int]int32, len(config.Scancodes))
+		for key, scancode := range config.Scancodes {
+			scancodes[key] = scancode
+		}
+	}
+
+	fd, err := createVKeyboardDevice(path, name, keys, config.Repeat, scancodes != nil)
 	if err != nil {
 		return nil, err
 	}
 
-	return vKeyboard{name: name, deviceFile: fd}, nil
+	if config.Repeat {
+		delay, period := config.RepeatDelay, config.RepeatPeriod
+		if delay == 0 {
+			delay = defaultRepeatDelay
+		}
+		if period == 0 {
+			period = defaultRepeatPeriod
+		}
+		err = sendRepeatSettings(fd, delay, period)
+		if err != nil {
+			_ = closeDevice(fd)
+			return nil, fmt.Errorf("failed to set autorepeat of keyboard: %v", err)
+		}
+	}
+
+	layout := config.Layout
+	if layout == nil {
+		layout = LayoutUS
+	}
+
+	var timer *typingTimer
+	if config.Cadence != nil {
+		timer = newTypingTimer(*config.Cadence)
+	}
+
+	return &vKeyboard{
+		name:       name,
+		deviceFile: fd,
+		keys:       keySet,
+		layout:     layout,
+		fallbacks:  config.UnicodeFallbacks,
+		capsLock:   config.CapsLock,
+		scancodes:  scancodes,
+		timer:      timer,
+		state:      newInputState(),
+		warnUp:     config.WarnOnUnpressedRelease,
+	}, nil
+}
+
+// DefaultKeyboardKeys returns every key defined in input-event-codes.h (KEY_*), in ascending order.
+// Buttons (BTN_*) are not included, as they would make the keyboard look like a mouse or joystick.
+func DefaultKeyboardKeys() []int {
+	var keys []int
+	for code, name := range eventCodeNames[EvKey] {
+		if strings.HasPrefix(name, "KEY_") {
+			keys = append(keys, int(code))
+		}
+	}
+	sort.Ints(keys)
+	return keys
 }
 
 // KeyPress will issue a single key press (push down a key and then immediately release it).
-func (vk vKeyboard) KeyPress(key int) error {
-	if !keyCodeInRange(key) {
-		return fmt.Errorf("failed to perform KeyPress. Code %d is not in range", key)
+func (vk *vKeyboard) KeyPress(key int) error {
+	if err := vk.checkKey(key); err != nil {
+		return fmt.Errorf("failed to perform KeyPress. %v", err)
 	}
-	err := sendBtnEvent(vk.deviceFile, []int{key}, btnStatePressed)
+	vk.waitForKey(key)
+	err := vk.sendKey(key, btnStatePressed)
 	if err != nil {
 		return fmt.Errorf("failed to issue the KeyDown event: %v", err)
 	}
+	if vk.timer != nil {
+		vk.timer.hold()
+	}
 
-	return sendBtnEvent(vk.deviceFile, []int{key}, btnStateReleased)
+	return vk.sendKey(key, btnStateReleased)
 }
 
-// KeyDown will send the key code passed (see keycodes.go for available keycodes). Note that unless a key release
+// KeyDown will send the key code passed (see eventcodes.go for available keycodes). Note that unless a key release
 // event is sent to the device, the key will remain pressed and therefore input will continuously be generated. Therefore,
 // do not forget to call "KeyUp" afterwards.
-func (vk vKeyboard) KeyDown(key int) error {
-	if !keyCodeInRange(key) {
-		return fmt.Errorf("failed to perform KeyDown. Code %d is not in range", key)
+func (vk *vKeyboard) KeyDown(key int) error {
+	if err := vk.checkKey(key); err != nil {
+		return fmt.Errorf("failed to perform KeyDown. %v", err)
 	}
-	return sendBtnEvent(vk.deviceFile, []int{key}, btnStatePressed)
+	vk.waitForKey(key)
+	_, err := vk.changeKey(key, btnStatePressed)
+	return err
 }
 
-// KeyUp will release the given key passed as a parameter (see keycodes.go for available keycodes). In most
+// KeyUp will release the given key passed as a parameter (see eventcodes.go for available keycodes). In most
 // cases it is recommended to call this function immediately after the "KeyDown" function in order to only issue a
 // single key press.
-func (vk vKeyboard) KeyUp(key int) error {
-	if !keyCodeInRange(key) {
-		return fmt.Errorf("failed to perform KeyUp. Code %d is not in range", key)
+func (vk *vKeyboard) KeyUp(key int) error {
+	if err := vk.checkKey(key); err != nil {
+		return fmt.Errorf("failed to perform KeyUp. %v", err)
+	}
+	released, err := vk.changeKey(key, btnStateReleased)
+	if err != nil || released {
+		return err
+	}
+	return vk.state.releaseUnpressed(key, vk.warnUp)
+}
+
+// KeyHold will press the key, wait for the given duration and release the key again. The key is
+// released even if the duration is zero.
+func (vk *vKeyboard) KeyHold(key int, duration time.Duration) error {
+	if err := vk.checkKey(key); err != nil {
+		return fmt.Errorf("failed to perform KeyHold. %v", err)
+	}
+	vk.waitForKey(key)
+	if _, err := vk.changeKey(key, btnStatePressed); err != nil {
+		return fmt.Errorf("failed to issue the KeyDown event: %v", err)
+	}
+	time.Sleep(duration)
+	return vk.sendKey(key, btnStateReleased)
+}
+
+// KeyRepeat will send a repeat event for the given key. It is up to the caller to press the key
+// with KeyDown before and release it with KeyUp afterwards.
+func (vk *vKeyboard) KeyRepeat(key int) error {
+	if err := vk.checkKey(key); err != nil {
+		return fmt.Errorf("failed to perform KeyRepeat. %v", err)
+	}
+	return vk.sendKey(key, btnStateRepeated)
+}
+
+// TypeString will type the given text using the layout of the keyboard. Upper case letters and other
+// characters on the Shift and AltGr levels are typed by holding down the modifier while pressing the key,
+// taking the current Caps Lock state into account. Accented characters the layout has no key for are
+// typed using its dead keys, everything else using the unicode fallbacks of the keyboard.
+func (vk *vKeyboard) TypeString(text string) error {
+	type typedRune struct {
+		r        rune
+		strokes  []KeyStroke
+		fallback UnicodeFallback
 	}
 
-	return sendBtnEvent(vk.deviceFile, []int{key}, btnStateReleased)
+	var runes []typedRune
+	var unsupported []rune
+	for _, r := range text {
+		if s, ok := vk.layout.Strokes(r); ok && vk.canTypeStrokes(s) {
+			runes = append(runes, typedRune{r: r, strokes: s})
+			continue
+		}
+		fallback := vk.fallbackFor(r)
+		if fallback == nil {
+			unsupported = append(unsupported, r)
+			continue
+		}
+		runes = append(runes, typedRune{r: r, fallback: fallback})
+	}
+	if len(unsupported) > 0 {
+		return &UnsupportedRunesError{Layout: vk.layout.Name(), Runes: unsupported}
+	}
+
+	for _, tr := range runes {
+		if tr.fallback != nil {
+			if err := tr.fallback.TypeRune(vk, tr.r); err != nil {
+				return fmt.Errorf("failed to type %q: %v", tr.r, err)
+			}
+			continue
+		}
+		for _, s := range tr.strokes {
+			if err := vk.typeStroke(s); err != nil {
+				return fmt.Errorf("failed to type %q: %v", tr.r, err)
+			}
+		}
+	}
+	return nil
+}
+
+func (vk *vKeyboard) fallbackFor(r rune) UnicodeFallback {
+	for _, f := range vk.fallbacks {
+		if c, ok := f.(typeChecker); ok {
+			if c.canTypeOn(vk, r) {
+				return f
+			}
+			continue
+		}
+		if f.CanType(r) {
+			return f
+		}
+	}
+	return nil
+}
+
+// canTypeText reports whether every character of the text is on the layout and its keys are
+// registered, so typing it can't fail halfway through.
+func (vk *vKeyboard) canTypeText(text string) bool {
+	for _, r := range text {
+		s, ok := vk.layout.Strokes(r)
+		if !ok || !vk.canTypeStrokes(s) {
+			return false
+		}
+	}
+	return true
 }
 
-// Close will close the device and free resources.
+// canTypeStrokes reports whether the keys and modifiers of the strokes are registered.
+func (vk *vKeyboard) canTypeStrokes(strokes []KeyStroke) bool {
+	for _, s := range strokes {
+		if vk.capsLock {
+			s = vk.layout.withCapsLock(s)
+		}
+		if !vk.canTypeKeys(s.Key) || (s.Shift && !vk.canTypeKeys(KeyLeftshift)) || (s.AltGr && !vk.canTypeKeys(KeyRightalt)) {
+			return false
+		}
+	}
+	return true
+}
+
+func (vk *vKeyboard) canTypeKeys(keys ...int) bool {
+	for _, key := range keys {
+		if vk.checkKey(key) != nil {
+			return false
+		}
+	}
+	return true
+}
+
+// typeStroke presses the key of the stroke while holding down its modifiers.
+func (vk *vKeyboard) typeStroke(s KeyStroke) error {
+	if vk.capsLock {
+		s = vk.layout.withCapsLock(s)
+	}
+
+	var modifiers []int
+	if s.Shift {
+		modifiers = append(modifiers, KeyLeftshift)
+	}
+	if s.AltGr {
+		modifiers = append(modifiers, KeyRightalt)
+	}
+	return pressChord(vk, modifiers, s.Key)
+}
+
+// KeyChord will press the keys in order and release them in reverse order.
+func (vk *vKeyboard) KeyChord(keys ...int) error {
+	if len(keys) == 0 {
+		return fmt.Errorf("failed to perform KeyChord. No keys given")
+	}
+	return pressChord(vk, keys[:len(keys)-1], keys[len(keys)-1])
+}
+
+// PressShortcut will parse and perform the given shortcut.
+func (vk *vKeyboard) PressShortcut(shortcut ...string) error {
+	steps, err := ParseShortcut(shortcut...)
+	if err != nil {
+		return fmt.Errorf("failed to perform PressShortcut. %v", err)
+	}
+	return pressShortcut(vk, steps)
+}
+
+// waitForKey applies the typing cadence (if any) before the key is pressed.
+func (vk *vKeyboard) waitForKey(key int) {
+	if vk.timer != nil {
+		vk.timer.waitForKey(key)
+	}
+}
+
+// sendKey sends a single key event, preceded by the scancode of the key, and keeps track of the
+// Caps Lock state. Repeat events are sent without a scancode, as there is no physical key event behind them.
+func (vk *vKeyboard) sendKey(key int, btnState int) error {
+	vk.state.send.Lock()
+	defer vk.state.send.Unlock()
+	err := sendEvents(vk.deviceFile, vk.keyEvents(key, btnState))
+	if err != nil {
+		return err
+	}
+	vk.trackKey(key, btnState)
+	return nil
+}
+
+// changeKey sends a key press or release unless the key already is in that state. The state is
+// checked under the frame lock, so a watchdog or stream can't change it before the key is sent.
+// It reports whether the key was sent.
+func (vk *vKeyboard) changeKey(key int, btnState int) (bool, error) {
+	vk.state.send.Lock()
+	defer vk.state.send.Unlock()
+	if vk.state.isPressed(key) == (btnState == btnStatePressed) {
+		return false, nil
+	}
+	err := sendEvents(vk.deviceFile, vk.keyEvents(key, btnState))
+	if err != nil {
+		return false, err
+	}
+	vk.trackKey(key, btnState)
+	return true, nil
+}
+
+// keyEvents returns the events of a key press, release or repeat: the scancode of the key, if
+// there is one, and the key event.
+func (vk *vKeyboard) keyEvents(key int, btnState int) []inputEvent {
+	var events []inputEvent
+	if scancode, ok := vk.scancodes[key]; ok && btnState != btnStateRepeated {
+		events = append(events, inputEvent{Type: EvMsc, Code: MscScan, Value: scancode})
+	}
+	return append(events, inputEvent{Type: EvKey, Code: uint16(key), Value: int32(btnState)})
+}
+
+// trackKey records a key event that was sent, including the Caps Lock state.
+func (vk *vKeyboard) trackKey(key int, btnState int) {
+	if btnState == btnStateRepeated {
+		return
+	}
+	vk.state.setPressed(key, btnState == btnStatePressed)
+	if key == KeyCapslock && btnState == btnStatePressed {
+		vk.capsLock = !vk.capsLock
+	}
+}
+
+// IsPressed reports whether the key is held down.
+func (vk *vKeyboard) IsPressed(key int) bool {
+	return vk.state.isPressed(key)
+}
+
+// Pressed returns the keys that are held down.
+func (vk *vKeyboard) Pressed() []int {
+	return vk.state.pressedKeys()
+}
+
+// Snapshot returns the keys held down and the Caps Lock state.
+func (vk *vKeyboard) Snapshot() KeyboardState {
+	return KeyboardState{Pressed: vk.state.pressedKeys(), CapsLock: vk.capsLock}
+}
+
+// releaseTracked releases a key for the watchdog, with its scancode and the Caps Lock tracking of
+// KeyUp, if it is still held down since the given time.
+func (vk *vKeyboard) releaseTracked(code int, since time.Time) (bool, error) {
+	vk.state.send.Lock()
+	defer vk.state.send.Unlock()
+	if !vk.state.heldSince(code, since) {
+		return false, nil
+	}
+	err := sendEvents(vk.deviceFile, vk.keyEvents(code, btnStateReleased))
+	if err != nil {
+		return true, err
+	}
+	vk.trackKey(code, btnStateReleased)
+	return true, nil
+}
+
+func (vk *vKeyboard) trackedState() (*os.File, *inputState) {
+	return vk.deviceFile, vk.state
+}
+
+// ReleaseAll will release every key that is held down. The keys are released in a single frame
+// with their scancodes, like KeyUp releases them.
+func (vk *vKeyboard) ReleaseAll() error {
+	vk.state.send.Lock()
+	defer vk.state.send.Unlock()
+	keys := vk.state.pressedKeys()
+	var events []inputEvent
+	for _, key := range keys {
+		events = append(events, vk.keyEvents(key, btnStateReleased)...)
+	}
+	if len(events) == 0 {
+		return nil
+	}
+	err := sendEvents(vk.deviceFile, events)
+	if err != nil {
+		return fmt.Errorf("failed to release held inputs: %v", err)
+	}
+	for _, key := range keys {
+		vk.trackKey(key, btnStateReleased)
+	}
+	return nil
+}
+
+// Close will release every key that is held down, close the device and free resources.
 // It's usually a good idea to use defer to call this function.
-func (vk vKeyboard) Close() error {
-	return closeDevice(vk.deviceFile)
+func (vk *vKeyboard) Close() error {
+	return closeReleased(vk.deviceFile, vk.state, vk.ReleaseAll())
 }
 
-func createVKeyboardDevice(path string, name []byte) (fd *os.File, err error) {
+func createVKeyboardDevice(path string, name []byte, keys []int, repeat, scancodes bool) (fd *os.File, err error) {
 	deviceFile, err := createDeviceFile(path)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create virtual keyboard device: %v", err)
 	}
 
-	err = registerDevice(deviceFile, uintptr(evKey))
+	err = registerDevice(deviceFile, uintptr(EvKey))
 	if err != nil {
 		deviceFile.Close()
 		return nil, fmt.Errorf("failed to register virtual keyboard device: %v", err)
 	}
 
+	if repeat {
+		err = registerDevice(deviceFile, uintptr(EvRep))
+		if err != nil {
+			_ = deviceFile.Close()
+			return nil, fmt.Errorf("failed to register autorepeat of virtual keyboard device: %v", err)
+		}
+	}
+
+	if scancodes {
+		err = registerDevice(deviceFile, uintptr(EvMsc))
+		if err != nil {
+			_ = deviceFile.Close()
+			return nil, fmt.Errorf("failed to register scancodes of virtual keyboard device: %v", err)
+		}
+		err = ioctl(deviceFile, uiSetMscBit, uintptr(MscScan))
+		if err != nil {
+			_ = deviceFile.Close()
+			return nil, fmt.Errorf("failed to register scancode event: %v", err)
+		}
+	}
+
 	// register key events
-	for i := 0; i <= keyMax; i++ {
-		err = ioctl(deviceFile, uiSetKeyBit, uintptr(i))
+	for _, key := range keys {
+		err = ioctl(deviceFile, uiSetKeyBit, uintptr(key))
 		if err != nil {
 			deviceFile.Close()
-			return nil, fmt.Errorf("failed to register key number %d: %v", i, err)
+			return nil, fmt.Errorf("failed to register key number %d: %v", key, err)
 		}
 	}
 
@@ -117,16 +588,50 @@ func createVKeyboardDevice(path string, name []byte) (fd *os.File, err error) {
 		uinputUserDev{
 			Name: toUinputName(name),
 			ID: inputID{
-				Bustype: busUsb,
+				Bustype: BusUsb,
 				Vendor:  0x4711,
 				Product: 0x0815,
 				Version: 1}})
 }
 
+// sendRepeatSettings sets the autorepeat delay and period of a device. The kernel takes both
+// values in milliseconds.
+func sendRepeatSettings(deviceFile *os.File, delay, period time.Duration) error {
+	for _, setting := range []struct {
+		code  uint16
+		value time.Duration
+	}{{RepDelay, delay}, {RepPeriod, period}} {
+		buf, err := inputEventToBuffer(inputEvent{
+			Time:  syscall.Timeval{Sec: 0, Usec: 0},
+			Type:  EvRep,
+			Code:  setting.code,
+			Value: int32(setting.value / time.Millisecond)})
+		if err != nil {
+			return fmt.Errorf("repeat event could not be set: %v", err)
+		}
+		_, err = deviceFile.Write(buf)
+		if err != nil {
+			return fmt.Errorf("writing repeat event to the device file failed: %v", err)
+		}
+	}
+	return syncEvents(deviceFile)
+}
+
 func keyCodeInRange(key int) bool {
-	return key >= keyReserved && key <= keyMax
+	return key >= KeyReserved && key <= KeyMax
+}
+
+// checkKey makes sure the key can be sent, i.e. it is advertised by the keyboard.
+func (vk *vKeyboard) checkKey(key int) error {
+	if !keyCodeInRange(key) {
+		return fmt.Errorf("Code %d is not in range", key)
+	}
+	if !vk.keys[key] {
+		return fmt.Errorf("Key %v is not registered on this keyboard", KeyName(key))
+	}
+	return nil
 }
 
-func (vk vKeyboard) FetchSyspath() (string, error) {
+func (vk *vKeyboard) FetchSyspath() (string, error) {
 	return fetchSyspath(vk.deviceFile)
 }
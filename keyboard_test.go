@@ -4,12 +4,14 @@ import (
 	"fmt"
 	"io/ioutil"
 	"os"
+	"sync"
 	"testing"
+	"time"
 )
 
 // This test will confirm that basic key events are working.
 // Note that only Key1 is used here, as the purpose of this test is to ensure that the event handling for
-// keyboard devices is working. All other keys, defined in keycodes.go should work as well if this test passes.
+// keyboard devices is working. All other keys, defined in eventcodes.go should work as well if this test passes.
 // Another thing to keep in mind is that there are certain key codes that might not be great candidates for
 // unit testing, as they may create unwanted side effects, like logging out the current user, etc...
 func TestKeysInValidRangeWork(t *testing.T) {
@@ -18,7 +20,7 @@ func TestKeysInValidRangeWork(t *testing.T) {
 		t.Fatalf("Failed to create the virtual keyboard. Last error was: %s\n", err)
 	}
 
-	for _, key := range []int{keyReserved, keyMax} {
+	for _, key := range []int{KeyReserved, KeyMicmute, KeyFn, KeyKbdillumup, KeyMacro30} {
 		err = vk.KeyPress(key)
 		if err != nil {
 			t.Fatalf("Failed to send key press. Last error was: %s\n", err)
@@ -42,6 +44,48 @@ func TestKeysInValidRangeWork(t *testing.T) {
 	}
 }
 
+func TestKeyboardWithConfigOnlySendsConfiguredKeys(t *testing.T) {
+	vk, err := CreateKeyboardWithConfig("/dev/uinput", []byte("Test Small Keyboard"), KeyboardConfig{Keys: []int{KeyA, KeyLeftshift}})
+	if err != nil {
+		t.Fatalf("Failed to create the virtual keyboard. Last error was: %s\n", err)
+	}
+	defer vk.Close()
+
+	err = vk.KeyPress(KeyA)
+	if err != nil {
+		t.Fatalf("Failed to send key press. Last error was: %s\n", err)
+	}
+
+	err = vk.KeyPress(KeyB)
+	if err == nil {
+		t.Fatalf("Expected key press to fail due to unregistered key, but got no error.")
+	}
+}
+
+func TestKeyboardWithConfigFailsOnInvalidKey(t *testing.T) {
+	_, err := CreateKeyboardWithConfig("/dev/uinput", []byte("Test Small Keyboard"), KeyboardConfig{Keys: []int{KeyMax + 1}})
+	if err == nil {
+		t.Fatalf("Expected keyboard creation to fail due to invalid key code, but got no error.")
+	}
+}
+
+func TestDefaultKeyboardKeysContainsOnlyKeys(t *testing.T) {
+	keys := map[int]bool{}
+	for _, key := range DefaultKeyboardKeys() {
+		keys[key] = true
+	}
+	for _, key := range []int{KeyEsc, KeyMicmute, KeyFn, KeyMacro1, KeyAssistant} {
+		if !keys[key] {
+			t.Fatalf("Expected %s to be part of the default keys", KeyName(key))
+		}
+	}
+	for _, key := range []int{BtnLeft, BtnSouth, BtnTouch} {
+		if keys[key] {
+			t.Fatalf("Expected %s not to be part of the default keys", KeyName(key))
+		}
+	}
+}
+
 func TestKeyboardCreationFailsOnEmptyPath(t *testing.T) {
 	expected := "device path must not be empty"
 	_, err := CreateKeyboard("", []byte("KeyboardDevice"))
@@ -88,7 +132,7 @@ func TestKeyOutsideOfRangeKeyPressFails(t *testing.T) {
 	}
 	defer vk.Close()
 
-	err = vk.KeyPress(keyMax + 1)
+	err = vk.KeyPress(KeyMax + 1)
 	if err == nil {
 		t.Fatalf("Expected key press to fail due to invalid key code, but got no error.")
 	}
@@ -106,7 +150,7 @@ func TestKeyOutsideOfRangeKeyUpFails(t *testing.T) {
 	}
 	defer vk.Close()
 
-	err = vk.KeyUp(keyMax + 1)
+	err = vk.KeyUp(KeyMax + 1)
 	if err == nil {
 		t.Fatalf("Expected key press to fail due to invalid key code, but got no error.")
 	}
@@ -125,7 +169,7 @@ func TestKeyOutsideOfRangeKeyDownFails(t *testing.T) {
 	}
 	defer vk.Close()
 
-	err = vk.KeyDown(keyMax + 1)
+	err = vk.KeyDown(KeyMax + 1)
 	if err == nil {
 		t.Fatalf("Expected key press to fail due to invalid key code, but got no error.")
 	}
@@ -192,3 +236,319 @@ func TestKeyboardSyspath(t *testing.T) {
 	}
 	t.Logf("Syspath: %s", sysPath)
 }
+
+func createTestKeyboard(t *testing.T, config KeyboardConfig) *vKeyboard {
+	keys := map[int]bool{}
+	for _, key := range DefaultKeyboardKeys() {
+		keys[key] = true
+	}
+	layout := config.Layout
+	if layout == nil {
+		layout = LayoutUS
+	}
+	return &vKeyboard{name: []byte("Test Keyboard"), deviceFile: createTestDeviceFile(t), keys: keys, layout: layout, capsLock: config.CapsLock, scancodes: config.Scancodes, state: newInputState(), warnUp: config.WarnOnUnpressedRelease}
+}
+
+func TestTypeStringUsesModifiers(t *testing.T) {
+	vk := createTestKeyboard(t, KeyboardConfig{})
+	defer removeTestDeviceFile(vk.deviceFile)
+
+	err := vk.TypeString("aB")
+	if err != nil {
+		t.Fatalf("Failed to type string. Last error was: %s\n", err)
+	}
+
+	expected := []inputEvent{
+		{Type: EvKey, Code: KeyA, Value: 1},
+		{Type: EvKey, Code: KeyA, Value: 0},
+		{Type: EvKey, Code: KeyLeftshift, Value: 1},
+		{Type: EvKey, Code: KeyB, Value: 1},
+		{Type: EvKey, Code: KeyB, Value: 0},
+		{Type: EvKey, Code: KeyLeftshift, Value: 0},
+	}
+	assertEvents(t, readTestEvents(t, vk.deviceFile), expected)
+}
+
+func TestTypeStringRespectsCapsLock(t *testing.T) {
+	vk := createTestKeyboard(t, KeyboardConfig{})
+	defer removeTestDeviceFile(vk.deviceFile)
+
+	err := vk.KeyPress(KeyCapslock)
+	if err != nil {
+		t.Fatalf("Failed to send key press. Last error was: %s\n", err)
+	}
+	err = vk.TypeString("A1")
+	if err != nil {
+		t.Fatalf("Failed to type string. Last error was: %s\n", err)
+	}
+
+	expected := []inputEvent{
+		{Type: EvKey, Code: KeyCapslock, Value: 1},
+		{Type: EvKey, Code: KeyCapslock, Value: 0},
+		{Type: EvKey, Code: KeyA, Value: 1},
+		{Type: EvKey, Code: KeyA, Value: 0},
+		{Type: EvKey, Code: Key1, Value: 1},
+		{Type: EvKey, Code: Key1, Value: 0},
+	}
+	assertEvents(t, readTestEvents(t, vk.deviceFile), expected)
+}
+
+func TestTypeStringReportsUnsupportedRunes(t *testing.T) {
+	vk := createTestKeyboard(t, KeyboardConfig{Layout: LayoutDE})
+	defer removeTestDeviceFile(vk.deviceFile)
+
+	err := vk.TypeString("Grüße ☃ 😀")
+	unsupported, ok := err.(*UnsupportedRunesError)
+	if !ok {
+		t.Fatalf("Expected *UnsupportedRunesError, got %v", err)
+	}
+	if string(unsupported.Runes) != "☃😀" {
+		t.Fatalf("Expected: %q\nActual: %q", "☃😀", string(unsupported.Runes))
+	}
+	if events := readTestEvents(t, vk.deviceFile); len(events) != 0 {
+		t.Fatalf("Expected nothing to be typed, got %v", events)
+	}
+}
+
+func assertEvents(t *testing.T, actual []inputEvent, expected []inputEvent) {
+	t.Helper()
+	if len(actual) != len(expected) {
+		t.Fatalf("Expected %d events: %v\nActual %d events: %v", len(expected), expected, len(actual), actual)
+	}
+	for i := range expected {
+		if actual[i].Type != expected[i].Type || actual[i].Code != expected[i].Code || actual[i].Value != expected[i].Value {
+			t.Fatalf("Event %d\nExpected: %v\nActual: %v", i, expected[i], actual[i])
+		}
+	}
+}
+
+func TestKeyHoldPressesAndReleasesKey(t *testing.T) {
+	vk := createTestKeyboard(t, KeyboardConfig{})
+	defer removeTestDeviceFile(vk.deviceFile)
+
+	err := vk.KeyHold(KeyA, time.Millisecond)
+	if err != nil {
+		t.Fatalf("Failed to hold key. Last error was: %s\n", err)
+	}
+
+	expected := []inputEvent{
+		{Type: EvKey, Code: KeyA, Value: btnStatePressed},
+		{Type: EvKey, Code: KeyA, Value: btnStateReleased},
+	}
+	assertEvents(t, readTestEvents(t, vk.deviceFile), expected)
+}
+
+func TestKeyRepeatSendsRepeatEvent(t *testing.T) {
+	vk := createTestKeyboard(t, KeyboardConfig{})
+	defer removeTestDeviceFile(vk.deviceFile)
+
+	err := vk.KeyRepeat(KeyA)
+	if err != nil {
+		t.Fatalf("Failed to repeat key. Last error was: %s\n", err)
+	}
+
+	expected := []inputEvent{{Type: EvKey, Code: KeyA, Value: btnStateRepeated}}
+	assertEvents(t, readTestEvents(t, vk.deviceFile), expected)
+}
+
+func TestRepeatSettingsAreSentInMilliseconds(t *testing.T) {
+	file := createTestDeviceFile(t)
+	defer removeTestDeviceFile(file)
+
+	err := sendRepeatSettings(file, 500*time.Millisecond, 20*time.Millisecond)
+	if err != nil {
+		t.Fatalf("Failed to send repeat settings. Last error was: %s\n", err)
+	}
+
+	expected := []inputEvent{
+		{Type: EvRep, Code: RepDelay, Value: 500},
+		{Type: EvRep, Code: RepPeriod, Value: 20},
+	}
+	assertEvents(t, readTestEvents(t, file), expected)
+}
+
+func TestScancodesAreSentBeforeKeyEvents(t *testing.T) {
+	vk := createTestKeyboard(t, KeyboardConfig{Scancodes: map[int]int32{KeyA: 0x70004}})
+	defer removeTestDeviceFile(vk.deviceFile)
+
+	err := vk.KeyPress(KeyA)
+	if err != nil {
+		t.Fatalf("Failed to press key. Last error was: %s\n", err)
+	}
+	err = vk.KeyPress(KeyB)
+	if err != nil {
+		t.Fatalf("Failed to press key. Last error was: %s\n", err)
+	}
+	err = vk.KeyRepeat(KeyA)
+	if err != nil {
+		t.Fatalf("Failed to repeat key. Last error was: %s\n", err)
+	}
+
+	expected := []inputEvent{
+		{Type: EvMsc, Code: MscScan, Value: 0x70004},
+		{Type: EvKey, Code: KeyA, Value: btnStatePressed},
+		{Type: EvMsc, Code: MscScan, Value: 0x70004},
+		{Type: EvKey, Code: KeyA, Value: btnStateReleased},
+		{Type: EvKey, Code: KeyB, Value: btnStatePressed},
+		{Type: EvKey, Code: KeyB, Value: btnStateReleased},
+		{Type: EvKey, Code: KeyA, Value: btnStateRepeated},
+	}
+	assertEvents(t, readTestEvents(t, vk.deviceFile), expected)
+}
+
+func TestKeyboardTracksPressedKeys(t *testing.T) {
+	vk := createTestKeyboard(t, KeyboardConfig{})
+	defer removeTestDeviceFile(vk.deviceFile)
+
+	for _, key := range []int{KeyLeftshift, KeyA, KeyA, KeyCapslock} {
+		if err := vk.KeyDown(key); err != nil {
+			t.Fatalf("Failed to press key. Last error was: %s\n", err)
+		}
+	}
+	if !vk.IsPressed(KeyA) || vk.IsPressed(KeyB) {
+		t.Fatalf("Expected only KeyA to be pressed, got %v", vk.Pressed())
+	}
+
+	state := vk.Snapshot()
+	expected := []int{KeyA, KeyLeftshift, KeyCapslock}
+	if fmt.Sprint(state.Pressed) != fmt.Sprint(expected) || !state.CapsLock {
+		t.Fatalf("Expected: %v with caps lock\nActual: %+v", expected, state)
+	}
+
+	// the second KeyDown of KeyA is not sent
+	events := readTestEvents(t, vk.deviceFile)
+	if len(events) != 3 {
+		t.Fatalf("Expected 3 events, got %v", events)
+	}
+}
+
+func TestKeyUpOfUnpressedKey(t *testing.T) {
+	vk := createTestKeyboard(t, KeyboardConfig{})
+	defer removeTestDeviceFile(vk.deviceFile)
+
+	err := vk.KeyUp(KeyA)
+	if err != nil {
+		t.Fatalf("Expected releasing an unpressed key to be a no-op, got: %s", err)
+	}
+	assertEvents(t, readTestEvents(t, vk.deviceFile), nil)
+
+	vk.warnUp = true
+	err = vk.KeyUp(KeyA)
+	notPressed, ok := err.(*NotPressedError)
+	if !ok || notPressed.Code != KeyA {
+		t.Fatalf("Expected a *NotPressedError for KeyA, got: %v", err)
+	}
+}
+
+func TestModifiersHeldByCallerStayPressed(t *testing.T) {
+	vk := createTestKeyboard(t, KeyboardConfig{})
+	defer removeTestDeviceFile(vk.deviceFile)
+
+	if err := vk.KeyDown(KeyLeftctrl); err != nil {
+		t.Fatalf("Failed to press key. Last error was: %s\n", err)
+	}
+	if err := vk.KeyChord(KeyLeftctrl, KeyC); err != nil {
+		t.Fatalf("Failed to press chord. Last error was: %s\n", err)
+	}
+	if !vk.IsPressed(KeyLeftctrl) {
+		t.Fatalf("Expected KeyLeftctrl to still be held down")
+	}
+}
+
+func TestKeyboardReleaseAll(t *testing.T) {
+	vk := createTestKeyboard(t, KeyboardConfig{})
+	defer removeTestDeviceFile(vk.deviceFile)
+
+	for _, key := range []int{KeyLeftctrl, KeyLeftalt} {
+		if err := vk.KeyDown(key); err != nil {
+			t.Fatalf("Failed to press key. Last error was: %s\n", err)
+		}
+	}
+	if err := vk.ReleaseAll(); err != nil {
+		t.Fatalf("Failed to release all keys. Last error was: %s\n", err)
+	}
+
+	if len(vk.Pressed()) != 0 {
+		t.Fatalf("Expected no keys to be pressed, got %v", vk.Pressed())
+	}
+	expected := []inputEvent{
+		{Type: EvKey, Code: KeyLeftctrl, Value: btnStatePressed},
+		{Type: EvKey, Code: KeyLeftalt, Value: btnStatePressed},
+		{Type: EvKey, Code: KeyLeftctrl, Value: btnStateReleased},
+		{Type: EvKey, Code: KeyLeftalt, Value: btnStateReleased},
+	}
+	assertEvents(t, readTestEvents(t, vk.deviceFile), expected)
+}
+
+func TestKeyboardReleaseAllSendsScancodes(t *testing.T) {
+	vk := createTestKeyboard(t, KeyboardConfig{Scancodes: map[int]int32{KeyLeftshift: 0x700e1}})
+	defer removeTestDeviceFile(vk.deviceFile)
+
+	for _, key := range []int{KeyLeftshift, KeyCapslock} {
+		if err := vk.KeyDown(key); err != nil {
+			t.Fatalf("Failed to press key. Last error was: %s\n", err)
+		}
+	}
+	if err := vk.ReleaseAll(); err != nil {
+		t.Fatalf("Failed to release all keys. Last error was: %s\n", err)
+	}
+
+	frames := readTestFrames(t, vk.deviceFile)
+	assertEvents(t, frames[len(frames)-1], []inputEvent{
+		{Type: EvMsc, Code: MscScan, Value: 0x700e1},
+		{Type: EvKey, Code: KeyLeftshift, Value: btnStateReleased},
+		{Type: EvKey, Code: KeyCapslock, Value: btnStateReleased},
+	})
+	if state := vk.Snapshot(); len(state.Pressed) != 0 || !state.CapsLock {
+		t.Fatalf("Expected no keys to be held and Caps Lock to stay on, got %+v", state)
+	}
+}
+
+func TestKeyDownPressesOnceWhenCalledConcurrently(t *testing.T) {
+	vk := createTestKeyboard(t, KeyboardConfig{})
+	defer removeTestDeviceFile(vk.deviceFile)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_ = vk.KeyDown(KeyA)
+		}()
+	}
+	wg.Wait()
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_ = vk.KeyUp(KeyA)
+		}()
+	}
+	wg.Wait()
+
+	assertEvents(t, readTestEvents(t, vk.deviceFile), []inputEvent{
+		{Type: EvKey, Code: KeyA, Value: btnStatePressed},
+		{Type: EvKey, Code: KeyA, Value: btnStateReleased},
+	})
+}
+
+func TestKeyPressPressesAndReleasesHeldKey(t *testing.T) {
+	vk := createTestKeyboard(t, KeyboardConfig{})
+	defer removeTestDeviceFile(vk.deviceFile)
+
+	if err := vk.KeyDown(KeyA); err != nil {
+		t.Fatalf("Failed to press key. Last error was: %s\n", err)
+	}
+	if err := vk.KeyPress(KeyA); err != nil {
+		t.Fatalf("Failed to press key. Last error was: %s\n", err)
+	}
+
+	assertEvents(t, readTestEvents(t, vk.deviceFile), []inputEvent{
+		{Type: EvKey, Code: KeyA, Value: btnStatePressed},
+		{Type: EvKey, Code: KeyA, Value: btnStatePressed},
+		{Type: EvKey, Code: KeyA, Value: btnStateReleased},
+	})
+	if vk.IsPressed(KeyA) {
+		t.Fatalf("Expected KeyA to be released")
+	}
+}
@@ -0,0 +1,251 @@
+package uinput
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+)
+
+// A KeyStroke is a single key press together with the modifiers that have to be held down while
+// the key is pressed in order to produce a character.
+type KeyStroke struct {
+	Key   int
+	Shift bool
+	AltGr bool
+}
+
+// A Layout maps characters to the key strokes that produce them on a keyboard layout as configured
+// in the desktop environment (the virtual keyboard itself only sends key codes, so the layout used
+// here has to match the one the system applies to it).
+type Layout struct {
+	name    string
+	strokes map[rune]KeyStroke
+	// dead maps the combining character of an accent to the dead key producing it
+	dead map[rune]KeyStroke
+	// alphabetic holds the keys Caps Lock applies to (lower case letter on the plain level)
+	alphabetic map[int]bool
+}
+
+// UnsupportedRunesError is returned if some characters of a text can not be produced.
+// Nothing is typed in that case.
+type UnsupportedRunesError struct {
+	Layout string
+	Runes  []rune
+}
+
+func (e *UnsupportedRunesError) Error() string {
+	return fmt.Sprintf("layout %s can not produce %q", e.Layout, string(e.Runes))
+}
+
+// Name returns the short name of the layout (the xkb name, e.g. "us" or "de").
+func (l *Layout) Name() string {
+	return l.name
+}
+
+// Strokes returns the key strokes needed to produce the given rune. Characters that need a dead
+// key take two strokes: the dead key and the base character (or space for the accent itself).
+func (l *Layout) Strokes(r rune) ([]KeyStroke, bool) {
+	if s, ok := l.strokes[r]; ok {
+		return []KeyStroke{s}, true
+	}
+	if c, ok := composedRunes[r]; ok {
+		dead, hasDead := l.dead[c.accent]
+		base, hasBase := l.strokes[c.base]
+		if hasDead && hasBase {
+			return []KeyStroke{dead, base}, true
+		}
+	}
+	for accent, spacing := range spacingAccents {
+		if spacing != r {
+			continue
+		}
+		if dead, ok := l.dead[accent]; ok {
+			return []KeyStroke{dead, l.strokes[' ']}, true
+		}
+	}
+	return nil, false
+}
+
+// withCapsLock returns the stroke that produces the same character while Caps Lock is on.
+// Caps Lock inverts Shift for letters, but not on the AltGr levels.
+func (l *Layout) withCapsLock(s KeyStroke) KeyStroke {
+	if l.alphabetic[s.Key] && !s.AltGr {
+		s.Shift = !s.Shift
+	}
+	return s
+}
+
+// combining characters used to mark dead keys in the layout tables
+const (
+	deadGrave      = "\u0300"
+	deadAcute      = "\u0301"
+	deadCircumflex = "\u0302"
+	deadTilde      = "\u0303"
+	deadDiaeresis  = "\u0308"
+)
+
+// spacingAccents maps the combining character of every supported dead key to the character
+// the dead key produces when followed by space.
+var spacingAccents = map[rune]rune{
+	'\u0300': '`',
+	'\u0301': '´',
+	'\u0302': '^',
+	'\u0303': '~',
+	'\u0308': '¨',
+}
+
+type composition struct {
+	accent rune
+	base   rune
+}
+
+// composedRunes maps precomposed characters to their accent and base character.
+var composedRunes = buildComposedRunes()
+
+func buildComposedRunes() map[rune]composition {
+	composed := map[rune]composition{}
+	for accent, pairs := range map[rune]string{
+		'\u0300': "aàeèiìoòuùAÀEÈIÌOÒUÙ",
+		'\u0301': "aáeéiíoóuúyýAÁEÉIÍOÓUÚYÝ",
+		'\u0302': "aâeêiîoôuûAÂEÊIÎOÔUÛ",
+		'\u0303': "aãnñoõAÃNÑOÕ",
+		'\u0308': "aäeëiïoöuüyÿAÄEËIÏOÖUÜ",
+	} {
+		runes := []rune(pairs)
+		for i := 0; i < len(runes); i += 2 {
+			composed[runes[i+1]] = composition{accent: accent, base: runes[i]}
+		}
+	}
+	return composed
+}
+
+// the physical rows of a (ISO) keyboard, from the number row down to the bottom row
+var (
+	layoutRowE = []int{KeyGrave, Key1, Key2, Key3, Key4, Key5, Key6, Key7, Key8, Key9, Key0, KeyMinus, KeyEqual}
+	layoutRowD = []int{KeyQ, KeyW, KeyE, KeyR, KeyT, KeyY, KeyU, KeyI, KeyO, KeyP, KeyLeftbrace, KeyRightbrace}
+	layoutRowC = []int{KeyA, KeyS, KeyD, KeyF, KeyG, KeyH, KeyJ, KeyK, KeyL, KeySemicolon, KeyApostrophe, KeyBackslash}
+	layoutRowB = []int{Key102Nd, KeyZ, KeyX, KeyC, KeyV, KeyB, KeyN, KeyM, KeyComma, KeyDot, KeySlash}
+)
+
+// noChar marks a key that produces nothing on a level
+const noChar = '\x00'
+
+// layoutLevels holds the characters of one row on the plain, shift, AltGr and AltGr+Shift level.
+// Every string has one character per key of the row, noChar for keys without a character.
+// Missing levels are empty strings.
+type layoutLevels [4]string
+
+func newLayout(name string, rows [4]layoutLevels) *Layout {
+	l := &Layout{
+		name:       name,
+		strokes:    map[rune]KeyStroke{},
+		dead:       map[rune]KeyStroke{},
+		alphabetic: map[int]bool{},
+	}
+	for i, keys := range [][]int{layoutRowE, layoutRowD, layoutRowC, layoutRowB} {
+		for level, chars := range rows[i] {
+			if chars == "" {
+				continue
+			}
+			runes := []rune(chars)
+			if len(runes) != len(keys) {
+				panic(fmt.Sprintf("layout %s: row %d level %d has %d characters, expected %d", name, i, level, len(runes), len(keys)))
+			}
+			for j, r := range runes {
+				if r == noChar {
+					continue
+				}
+				l.add(r, KeyStroke{Key: keys[j], Shift: level%2 == 1, AltGr: level >= 2})
+				if level == 0 && unicode.IsLower(r) {
+					l.alphabetic[keys[j]] = true
+				}
+			}
+		}
+	}
+	l.add(' ', KeyStroke{Key: KeySpace})
+	l.add('\n', KeyStroke{Key: KeyEnter})
+	l.add('\t', KeyStroke{Key: KeyTab})
+	return l
+}
+
+// add registers a stroke, the first (lowest level) stroke for a character wins.
+func (l *Layout) add(r rune, s KeyStroke) {
+	if _, ok := spacingAccents[r]; ok {
+		if _, ok := l.dead[r]; !ok {
+			l.dead[r] = s
+		}
+		return
+	}
+	if _, ok := l.strokes[r]; !ok {
+		l.strokes[r] = s
+	}
+}
+
+// LayoutUS is the US (ANSI) layout.
+var LayoutUS = newLayout("us", [4]layoutLevels{
+	{"`1234567890-=", "~!@#$%^&*()_+"},
+	{"qwertyuiop[]", "QWERTYUIOP{}"},
+	{"asdfghjkl;'\\", "ASDFGHJKL:\"|"},
+	{"\x00zxcvbnm,./", "\x00ZXCVBNM<>?"},
+})
+
+// LayoutUK is the British layout.
+var LayoutUK = newLayout("gb", [4]layoutLevels{
+	{"`1234567890-=", "¬!\"£$%^&*()_+", "¦\x00\x00\x00€\x00\x00\x00\x00\x00\x00\x00\x00"},
+	{"qwertyuiop[]", "QWERTYUIOP{}", "\x00\x00é\x00\x00\x00úíó\x00\x00\x00", "\x00\x00É\x00\x00\x00ÚÍÓ\x00\x00\x00"},
+	{"asdfghjkl;'#", "ASDFGHJKL:@~", "á\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00", "Á\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00"},
+	{"\\zxcvbnm,./", "|ZXCVBNM<>?"},
+})
+
+// LayoutDE is the German layout (with dead keys).
+var LayoutDE = newLayout("de", [4]layoutLevels{
+	{deadCircumflex + "1234567890ß" + deadAcute, "°!\"§$%&/()=?" + deadGrave, "\x00\x00²³\x00\x00\x00{[]}\\\x00"},
+	{"qwertzuiopü+", "QWERTZUIOPÜ*", "@\x00€\x00\x00\x00\x00\x00\x00\x00\x00~"},
+	{"asdfghjklöä#", "ASDFGHJKLÖÄ'"},
+	{"<yxcvbnm,.-", ">YXCVBNM;:_", "|\x00\x00\x00\x00\x00\x00µ\x00\x00\x00"},
+})
+
+// LayoutFR is the French (AZERTY) layout.
+var LayoutFR = newLayout("fr", [4]layoutLevels{
+	{"²&é\"'(-è_çà)=", "\x001234567890°+", "\x00\x00~#{[|`\\^@]}"},
+	{"azertyuiop" + deadCircumflex + "$", "AZERTYUIOP" + deadDiaeresis + "£", "\x00\x00€\x00\x00\x00\x00\x00\x00\x00\x00¤"},
+	{"qsdfghjklmù*", "QSDFGHJKLM%µ"},
+	{"<wxcvbn,;:!", ">WXCVBN?./§"},
+})
+
+// LayoutES is the Spanish layout.
+var LayoutES = newLayout("es", [4]layoutLevels{
+	{"º1234567890'¡", "ª!\"·$%&/()=?¿", "\\|@#\x00\x00¬\x00\x00\x00\x00\x00\x00"},
+	{"qwertyuiop" + deadGrave + "+", "QWERTYUIOP" + deadCircumflex + "*", "\x00\x00€\x00\x00\x00\x00\x00\x00\x00[]"},
+	{"asdfghjklñ" + deadAcute + "ç", "ASDFGHJKLÑ" + deadDiaeresis + "Ç", "\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00{}"},
+	{"<zxcvbnm,.-", ">ZXCVBNM;:_"},
+})
+
+// LayoutNordic is the Swedish/Finnish layout.
+var LayoutNordic = newLayout("se", [4]layoutLevels{
+	{"§1234567890+" + deadAcute, "½!\"#¤%&/()=?" + deadGrave, "\x00\x00@£$€\x00{[]}\\\x00"},
+	{"qwertyuiopå" + deadDiaeresis, "QWERTYUIOPÅ" + deadCircumflex, "\x00\x00€\x00\x00\x00\x00\x00\x00\x00\x00" + deadTilde},
+	{"asdfghjklöä'", "ASDFGHJKLÖÄ*"},
+	{"<zxcvbnm,.-", ">ZXCVBNM;:_", "|\x00\x00\x00\x00\x00\x00µ\x00\x00\x00"},
+})
+
+var layouts = map[string]*Layout{
+	"us":     LayoutUS,
+	"gb":     LayoutUK,
+	"uk":     LayoutUK,
+	"de":     LayoutDE,
+	"fr":     LayoutFR,
+	"es":     LayoutES,
+	"se":     LayoutNordic,
+	"fi":     LayoutNordic,
+	"nordic": LayoutNordic,
+}
+
+// LayoutByName returns the built-in layout with the given name ("us", "gb", "de", "fr", "es", "se").
+// "uk", "fi" and "nordic" are accepted as well.
+func LayoutByName(name string) (*Layout, error) {
+	if l, ok := layouts[strings.ToLower(name)]; ok {
+		return l, nil
+	}
+	return nil, fmt.Errorf("unknown keyboard layout %q", name)
+}
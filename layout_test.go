@@ -0,0 +1,66 @@
+package uinput
+
+import (
+	"testing"
+)
+
+func TestLayoutStrokes(t *testing.T) {
+	for _, tc := range []struct {
+		layout   *Layout
+		r        rune
+		expected []KeyStroke
+	}{
+		{LayoutUS, 'a', []KeyStroke{{Key: KeyA}}},
+		{LayoutUS, 'A', []KeyStroke{{Key: KeyA, Shift: true}}},
+		{LayoutUS, '!', []KeyStroke{{Key: Key1, Shift: true}}},
+		{LayoutUS, '\n', []KeyStroke{{Key: KeyEnter}}},
+		{LayoutUK, '£', []KeyStroke{{Key: Key3, Shift: true}}},
+		{LayoutUK, '€', []KeyStroke{{Key: Key4, AltGr: true}}},
+		{LayoutDE, 'z', []KeyStroke{{Key: KeyY}}},
+		{LayoutDE, '@', []KeyStroke{{Key: KeyQ, AltGr: true}}},
+		{LayoutDE, 'ü', []KeyStroke{{Key: KeyLeftbrace}}},
+		{LayoutDE, 'é', []KeyStroke{{Key: KeyEqual}, {Key: KeyE}}},
+		{LayoutDE, 'Ê', []KeyStroke{{Key: KeyGrave}, {Key: KeyE, Shift: true}}},
+		{LayoutDE, '^', []KeyStroke{{Key: KeyGrave}, {Key: KeySpace}}},
+		{LayoutFR, 'a', []KeyStroke{{Key: KeyQ}}},
+		{LayoutFR, '1', []KeyStroke{{Key: Key1, Shift: true}}},
+		{LayoutFR, 'ô', []KeyStroke{{Key: KeyLeftbrace}, {Key: KeyO}}},
+		{LayoutES, 'ñ', []KeyStroke{{Key: KeySemicolon}}},
+		{LayoutES, 'á', []KeyStroke{{Key: KeyApostrophe}, {Key: KeyA}}},
+		{LayoutNordic, 'å', []KeyStroke{{Key: KeyLeftbrace}}},
+		{LayoutNordic, '~', []KeyStroke{{Key: KeyRightbrace, AltGr: true}, {Key: KeySpace}}},
+	} {
+		strokes, ok := tc.layout.Strokes(tc.r)
+		if !ok {
+			t.Fatalf("Expected layout %s to produce %q", tc.layout.Name(), tc.r)
+		}
+		if len(strokes) != len(tc.expected) {
+			t.Fatalf("Layout %s, rune %q\nExpected: %v\nActual: %v", tc.layout.Name(), tc.r, tc.expected, strokes)
+		}
+		for i := range strokes {
+			if strokes[i] != tc.expected[i] {
+				t.Fatalf("Layout %s, rune %q\nExpected: %v\nActual: %v", tc.layout.Name(), tc.r, tc.expected, strokes)
+			}
+		}
+	}
+}
+
+func TestLayoutCanNotProduceEverything(t *testing.T) {
+	for _, r := range []rune{'ä', '€', '😀'} {
+		if _, ok := LayoutUS.Strokes(r); ok {
+			t.Fatalf("Expected layout us not to produce %q", r)
+		}
+	}
+}
+
+func TestLayoutByName(t *testing.T) {
+	for name, expected := range map[string]*Layout{"us": LayoutUS, "UK": LayoutUK, "de": LayoutDE, "nordic": LayoutNordic} {
+		l, err := LayoutByName(name)
+		if err != nil || l != expected {
+			t.Fatalf("Expected layout %s for %s, got %v (%v)", expected.Name(), name, l, err)
+		}
+	}
+	if _, err := LayoutByName("klingon"); err == nil {
+		t.Fatalf("Expected unknown layout to fail, but got no error.")
+	}
+}
@@ -3,8 +3,11 @@ package uinput
 import (
 	"fmt"
 	"io"
+	"math"
 	"os"
+	"sync"
 	"syscall"
+	"time"
 )
 
 // A Mouse is a device that will trigger an absolute change event.
@@ -27,6 +30,16 @@ type Mouse interface {
 	// values will cause a move towards the upper left corner.
 	Move(x, y int32) error
 
+	// MoveFloat will move the mouse pointer by a fractional number of pixels, scaled by
+	// MouseConfig.CountsPerPixel. The fractions that can't be sent yet are kept per axis and added to
+	// the next movement, so many small movements add up exactly.
+	MoveFloat(dx, dy float64) error
+
+	// MoveSmooth will move the mouse pointer by dx and dy in small steps spread over the given duration,
+	// following the easing (EaseLinear if nil). The pointer ends up exactly dx and dy away from
+	// where it started.
+	MoveSmooth(dx, dy int32, duration time.Duration, easing Easing) error
+
 	// LeftClick will issue a single left click.
 	LeftClick() error
 
@@ -57,9 +70,36 @@ type Mouse interface {
 	// MiddleRelease will simulate the release of the middle mouse button.
 	MiddleRelease() error
 
-	// Wheel will simulate a wheel movement.
+	// Press will simulate the press of the given button (BtnLeft to BtnTask). The button must be
+	// one of the buttons of the mouse, see MouseConfig. It will not be released until Release is invoked.
+	Press(button int) error
+
+	// Release will simulate the release of the given button.
+	Release(button int) error
+
+	// Click will click the given button count times, e.g. 2 for a double click. The button is held
+	// down and the clicks are spaced as configured in MouseConfig.Click.
+	Click(button int, count int) error
+
+	// Drag will press the button given in the options, move the pointer by dx and dy and release the
+	// button again. The button is released even if the movement fails.
+	Drag(dx, dy int32, options DragOptions) error
+
+	// Wheel will simulate a wheel movement by the given number of notches (detents).
 	Wheel(horizontal bool, delta int32) error
 
+	// WheelHiRes will simulate a high-resolution wheel movement. A notch is 120 units, the legacy
+	// wheel event is sent whenever the movement adds up to a full notch.
+	WheelHiRes(horizontal bool, units int32) error
+
+	// Scroll will scroll by the given number of high-resolution units (120 per notch) in small steps
+	// spread over the given duration, following the easing (EaseOut if nil).
+	Scroll(horizontal bool, units int32, duration time.Duration, easing Easing) error
+
+	// ReleaseAll will release every button that is held down in a single frame.
+	// Close does this as well before the device is destroyed.
+	ReleaseAll() error
+
 	// FetchSysPath will return the syspath to the device file.
 	FetchSyspath() (string, error)
 
@@ -69,11 +109,38 @@ type Mouse interface {
 type vMouse struct {
 	name       []byte
 	deviceFile *os.File
+	state      *inputState
+	wheel      *wheelRemainder
+	buttons    []int
+	click      ClickTiming
+	motion     *motionRemainder
+}
+
+// MouseConfig holds the settings of a mouse created with CreateMouseWithConfig.
+type MouseConfig struct {
+	// Buttons the mouse advertises, any of BtnLeft, BtnRight, BtnMiddle, BtnSide, BtnExtra,
+	// BtnForward, BtnBack and BtnTask. Left, right and middle if empty.
+	Buttons []int
+
+	// Click is the timing of the clicks issued by Click.
+	Click ClickTiming
+
+	// CountsPerPixel is the number of counts MoveFloat sends per pixel, the ratio of the resolution
+	// of the mouse to the resolution of the screen (e.g. 2 for 1600 DPI on a 800 DPI screen). 1 if zero.
+	CountsPerPixel float64
 }
 
+// the buttons of a mouse created with CreateMouse
+var defaultMouseButtons = []int{BtnLeft, BtnRight, BtnMiddle}
+
 // CreateMouse will create a new mouse input device. A mouse is a device that allows relative input.
 // Relative input means that all changes to the x and y coordinates of the mouse pointer will be
 func CreateMouse(path string, name []byte) (Mouse, error) {
+	return CreateMouseWithConfig(path, name, MouseConfig{})
+}
+
+// CreateMouseWithConfig will create a new mouse input device with the given configuration.
+func CreateMouseWithConfig(path string, name []byte, config MouseConfig) (Mouse, error) {
 	err := validateDevicePath(path)
 	if err != nil {
 		return nil, err
@@ -82,13 +149,24 @@ func CreateMouse(path string, name []byte) (Mouse, error) {
 	if err != nil {
 		return nil, err
 	}
+	buttons := config.Buttons
+	if len(buttons) == 0 {
+		buttons = defaultMouseButtons
+	}
+	if err = validateMouseButtons(buttons); err != nil {
+		return nil, err
+	}
+	if config.CountsPerPixel < 0 {
+		return nil, fmt.Errorf("counts per pixel must not be negative, got %v", config.CountsPerPixel)
+	}
 
-	fd, err := createMouse(path, name)
+	fd, err := createMouse(path, name, buttons)
 	if err != nil {
 		return nil, err
 	}
 
-	return vMouse{name: name, deviceFile: fd}, nil
+	return vMouse{name: name, deviceFile: fd, state: newInputState(), wheel: &wheelRemainder{}, buttons: buttons, click: config.Click,
+		motion: &motionRemainder{scale: config.CountsPerPixel}}, nil
 }
 
 // MoveLeft will move the cursor left by the number of pixel specified.
@@ -96,7 +174,7 @@ func (vRel vMouse) MoveLeft(pixel int32) error {
 	if err := assertNotNegative(pixel); err != nil {
 		return err
 	}
-	return sendRelEvent(vRel.deviceFile, relX, -pixel)
+	return sendRelEvent(vRel.deviceFile, RelX, -pixel)
 }
 
 // MoveRight will move the cursor right by the number of pixel specified.
@@ -104,7 +182,7 @@ func (vRel vMouse) MoveRight(pixel int32) error {
 	if err := assertNotNegative(pixel); err != nil {
 		return err
 	}
-	return sendRelEvent(vRel.deviceFile, relX, pixel)
+	return sendRelEvent(vRel.deviceFile, RelX, pixel)
 }
 
 // MoveUp will move the cursor up by the number of pixel specified.
@@ -112,7 +190,7 @@ func (vRel vMouse) MoveUp(pixel int32) error {
 	if err := assertNotNegative(pixel); err != nil {
 		return err
 	}
-	return sendRelEvent(vRel.deviceFile, relY, -pixel)
+	return sendRelEvent(vRel.deviceFile, RelY, -pixel)
 }
 
 // MoveDown will move the cursor down by the number of pixel specified.
@@ -120,113 +198,187 @@ func (vRel vMouse) MoveDown(pixel int32) error {
 	if err := assertNotNegative(pixel); err != nil {
 		return err
 	}
-	return sendRelEvent(vRel.deviceFile, relY, pixel)
+	return sendRelEvent(vRel.deviceFile, RelY, pixel)
 }
 
 // Move will perform a move of the mouse pointer along the x and y axes relative to the current position as requested.
 // Note that the upper left corner is (0, 0), so positive x and y means moving right (x) and down (y), whereas negative
 // values will cause a move towards the upper left corner.
 func (vRel vMouse) Move(x, y int32) error {
-	if err := sendRelEvent(vRel.deviceFile, relX, x); err != nil {
+	if err := sendRelEvent(vRel.deviceFile, RelX, x); err != nil {
 		return fmt.Errorf("Failed to move pointer along x axis: %v", err)
 	}
-	if err := sendRelEvent(vRel.deviceFile, relY, y); err != nil {
+	if err := sendRelEvent(vRel.deviceFile, RelY, y); err != nil {
 		return fmt.Errorf("Failed to move pointer along y axis: %v", err)
 	}
 	return nil
 }
 
+// MoveFloat will move the mouse pointer by a fractional number of pixels.
+func (vRel vMouse) MoveFloat(dx, dy float64) error {
+	return vRel.motion.send(vRel.deviceFile, dx, dy)
+}
+
+// MoveSmooth will move the mouse pointer by dx and dy in steps of smoothMoveInterval.
+func (vRel vMouse) MoveSmooth(dx, dy int32, duration time.Duration, easing Easing) error {
+	return moveSmooth(vRel.deviceFile, dx, dy, duration, easing, time.Sleep)
+}
+
 // LeftClick will issue a LeftClick.
 func (vRel vMouse) LeftClick() error {
-	err := sendBtnEvent(vRel.deviceFile, []int{evMouseBtnLeft}, btnStatePressed)
+	err := sendBtnEvent(vRel.deviceFile, []int{BtnLeft}, btnStatePressed)
 	if err != nil {
 		return fmt.Errorf("Failed to issue the LeftClick event: %v", err)
 	}
 
-	return sendBtnEvent(vRel.deviceFile, []int{evMouseBtnLeft}, btnStateReleased)
+	return sendBtnEvent(vRel.deviceFile, []int{BtnLeft}, btnStateReleased)
 }
 
 // RightClick will issue a RightClick
 func (vRel vMouse) RightClick() error {
-	err := sendBtnEvent(vRel.deviceFile, []int{evMouseBtnRight}, btnStatePressed)
+	err := sendBtnEvent(vRel.deviceFile, []int{BtnRight}, btnStatePressed)
 	if err != nil {
 		return fmt.Errorf("Failed to issue the RightClick event: %v", err)
 	}
 
-	return sendBtnEvent(vRel.deviceFile, []int{evMouseBtnRight}, btnStateReleased)
+	return sendBtnEvent(vRel.deviceFile, []int{BtnRight}, btnStateReleased)
 }
 
 // MiddleClick will issue a MiddleClick
 func (vRel vMouse) MiddleClick() error {
-	err := sendBtnEvent(vRel.deviceFile, []int{evMouseBtnMiddle}, btnStatePressed)
+	err := sendBtnEvent(vRel.deviceFile, []int{BtnMiddle}, btnStatePressed)
 	if err != nil {
 		return fmt.Errorf("Failed to issue the MiddleClick event: %v", err)
 	}
 
-	return sendBtnEvent(vRel.deviceFile, []int{evMouseBtnMiddle}, btnStateReleased)
+	return sendBtnEvent(vRel.deviceFile, []int{BtnMiddle}, btnStateReleased)
 }
 
 // LeftPress will simulate a press of the left mouse button. Note that the button will not be released until
 // LeftRelease is invoked.
 func (vRel vMouse) LeftPress() error {
-	return sendBtnEvent(vRel.deviceFile, []int{evMouseBtnLeft}, btnStatePressed)
+	return sendTrackedBtnEvent(vRel.deviceFile, vRel.state, BtnLeft, btnStatePressed)
 }
 
 // LeftRelease will simulate the release of the left mouse button.
 func (vRel vMouse) LeftRelease() error {
-	return sendBtnEvent(vRel.deviceFile, []int{evMouseBtnLeft}, btnStateReleased)
+	return sendTrackedBtnEvent(vRel.deviceFile, vRel.state, BtnLeft, btnStateReleased)
 }
 
 // RightPress will simulate the press of the right mouse button. Note that the button will not be released until
 // RightRelease is invoked.
 func (vRel vMouse) RightPress() error {
-	return sendBtnEvent(vRel.deviceFile, []int{evMouseBtnRight}, btnStatePressed)
+	return sendTrackedBtnEvent(vRel.deviceFile, vRel.state, BtnRight, btnStatePressed)
 }
 
 // RightRelease will simulate the release of the right mouse button.
 func (vRel vMouse) RightRelease() error {
-	return sendBtnEvent(vRel.deviceFile, []int{evMouseBtnRight}, btnStateReleased)
+	return sendTrackedBtnEvent(vRel.deviceFile, vRel.state, BtnRight, btnStateReleased)
 }
 
 // MiddlePress will simulate the press of the middle mouse button. Note that the button will not be released until
 // MiddleRelease is invoked.
 func (vRel vMouse) MiddlePress() error {
-	return sendBtnEvent(vRel.deviceFile, []int{evMouseBtnMiddle}, btnStatePressed)
+	return sendTrackedBtnEvent(vRel.deviceFile, vRel.state, BtnMiddle, btnStatePressed)
 }
 
 // MiddleRelease will simulate the release of the middle mouse button.
 func (vRel vMouse) MiddleRelease() error {
-	return sendBtnEvent(vRel.deviceFile, []int{evMouseBtnMiddle}, btnStateReleased)
+	return sendTrackedBtnEvent(vRel.deviceFile, vRel.state, BtnMiddle, btnStateReleased)
 }
 
-// Wheel will simulate a wheel movement.
-func (vRel vMouse) Wheel(horizontal bool, delta int32) error {
-	w := relWheel
-	if horizontal {
-		w = relHWheel
+// Press will simulate the press of the given button.
+func (vRel vMouse) Press(button int) error {
+	if err := vRel.assertButton(button); err != nil {
+		return err
+	}
+	return sendTrackedBtnEvent(vRel.deviceFile, vRel.state, button, btnStatePressed)
+}
+
+// Release will simulate the release of the given button.
+func (vRel vMouse) Release(button int) error {
+	if err := vRel.assertButton(button); err != nil {
+		return err
 	}
-	return sendRelEvent(vRel.deviceFile, uint16(w), delta)
+	return sendTrackedBtnEvent(vRel.deviceFile, vRel.state, button, btnStateReleased)
 }
 
-// Close closes the device and releases the device.
+// Click will click the given button count times.
+func (vRel vMouse) Click(button int, count int) error {
+	if err := vRel.assertButton(button); err != nil {
+		return err
+	}
+	return clickButton(vRel.deviceFile, vRel.state, button, count, vRel.click, time.Sleep)
+}
+
+// Drag will move the pointer by dx and dy while the button is held down.
+func (vRel vMouse) Drag(dx, dy int32, options DragOptions) error {
+	options = options.withDefaults()
+	if err := vRel.assertButton(options.Button); err != nil {
+		return err
+	}
+	return drag(vRel.deviceFile, vRel.state, options, time.Sleep, func() error {
+		return moveSmooth(vRel.deviceFile, dx, dy, options.Duration, options.Easing, time.Sleep)
+	})
+}
+
+// assertButton returns an error if the mouse doesn't advertise the button.
+func (vRel vMouse) assertButton(button int) error {
+	buttons := vRel.buttons
+	if buttons == nil {
+		buttons = defaultMouseButtons
+	}
+	return assertButton(buttons, button)
+}
+
+// Wheel will simulate a wheel movement. The high-resolution event is sent along with it.
+func (vRel vMouse) Wheel(horizontal bool, delta int32) error {
+	return sendWheelEvent(vRel.deviceFile, horizontal, delta)
+}
+
+// WheelHiRes will simulate a high-resolution wheel movement.
+func (vRel vMouse) WheelHiRes(horizontal bool, units int32) error {
+	return vRel.wheel.send(vRel.deviceFile, horizontal, units)
+}
+
+// Scroll will scroll smoothly by the given number of high-resolution units.
+func (vRel vMouse) Scroll(horizontal bool, units int32, duration time.Duration, easing Easing) error {
+	return vRel.wheel.scroll(vRel.deviceFile, horizontal, units, duration, easing, time.Sleep)
+}
+
+// releaseTracked releases a button for the watchdog.
+func (vRel vMouse) releaseTracked(code int, since time.Time) (bool, error) {
+	return releaseTrackedBtnEvent(vRel.deviceFile, vRel.state, code, since)
+}
+
+func (vRel vMouse) trackedState() (*os.File, *inputState) {
+	return vRel.deviceFile, vRel.state
+}
+
+// ReleaseAll will release every button that is held down.
+func (vRel vMouse) ReleaseAll() error {
+	return releaseAll(vRel.deviceFile, vRel.state)
+}
+
+// Close releases the buttons held down, closes the device and releases the device.
 func (vRel vMouse) Close() error {
-	return closeDevice(vRel.deviceFile)
+	return releaseAndClose(vRel.deviceFile, vRel.state)
 }
 
-func createMouse(path string, name []byte) (fd *os.File, err error) {
+func createMouse(path string, name []byte, buttons []int) (fd *os.File, err error) {
 	deviceFile, err := createDeviceFile(path)
 	if err != nil {
 		return nil, fmt.Errorf("could not create relative axis input device: %v", err)
 	}
 
-	err = registerDevice(deviceFile, uintptr(evKey))
+	err = registerDevice(deviceFile, uintptr(EvKey))
 	if err != nil {
 		deviceFile.Close()
 		return nil, fmt.Errorf("failed to register key device: %v", err)
 	}
 
-	// register button events (in order to enable left, right and middle click)
-	for _, event := range []int{evMouseBtnLeft, evMouseBtnRight, evMouseBtnMiddle} {
+	// register button events (in order to enable left, right, middle and extra clicks)
+	for _, event := range buttons {
 		err = ioctl(deviceFile, uiSetKeyBit, uintptr(event))
 		if err != nil {
 			deviceFile.Close()
@@ -234,14 +386,14 @@ func createMouse(path string, name []byte) (fd *os.File, err error) {
 		}
 	}
 
-	err = registerDevice(deviceFile, uintptr(evRel))
+	err = registerDevice(deviceFile, uintptr(EvRel))
 	if err != nil {
 		deviceFile.Close()
 		return nil, fmt.Errorf("failed to register relative axis input device: %v", err)
 	}
 
 	// register relative events
-	for _, event := range []int{relX, relY, relWheel, relHWheel} {
+	for _, event := range []int{RelX, RelY, RelWheel, RelHwheel, RelWheelHiRes, RelHwheelHiRes} {
 		err = ioctl(deviceFile, uiSetRelBit, uintptr(event))
 		if err != nil {
 			deviceFile.Close()
@@ -253,30 +405,229 @@ func createMouse(path string, name []byte) (fd *os.File, err error) {
 		uinputUserDev{
 			Name: toUinputName(name),
 			ID: inputID{
-				Bustype: busUsb,
+				Bustype: BusUsb,
 				Vendor:  0x4711,
 				Product: 0x0816,
 				Version: 1}})
 }
 
+func validateMouseButtons(buttons []int) error {
+	for _, button := range buttons {
+		if button < BtnLeft || button > BtnTask {
+			return fmt.Errorf("%#x is not a mouse button", button)
+		}
+	}
+	return nil
+}
+
 func sendRelEvent(deviceFile *os.File, eventCode uint16, pixel int32) error {
 	iev := inputEvent{
 		Time:  syscall.Timeval{Sec: 0, Usec: 0},
-		Type:  evRel,
+		Type:  EvRel,
 		Code:  eventCode,
 		Value: pixel}
 
-	buf, err := inputEventToBuffer(iev)
+	err := sendEvents(deviceFile, []inputEvent{iev})
 	if err != nil {
-		return fmt.Errorf("writing abs event failed: %v", err)
+		return fmt.Errorf("failed to write rel event to device file: %v", err)
 	}
+	return nil
+}
+
+// motionRemainder holds the fractions of counts of both axes that were not sent yet.
+type motionRemainder struct {
+	mu    sync.Mutex
+	scale float64
+	x, y  float64
+}
+
+// send sends the whole counts of a movement by dx, dy pixels and keeps the rest.
+func (m *motionRemainder) send(deviceFile *os.File, dx, dy float64) error {
+	if math.IsNaN(dx) || math.IsNaN(dy) || math.IsInf(dx, 0) || math.IsInf(dy, 0) {
+		return fmt.Errorf("invalid movement (%v, %v)", dx, dy)
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	scale := m.scale
+	if scale == 0 {
+		scale = 1
+	}
+	x := m.x + dx*scale
+	y := m.y + dy*scale
+	countsX, countsY := math.Round(x), math.Round(y)
+	if math.Abs(countsX) > math.MaxInt32 || math.Abs(countsY) > math.MaxInt32 {
+		return fmt.Errorf("movement (%v, %v) is out of range", dx, dy)
+	}
+
+	var events []inputEvent
+	if countsX != 0 {
+		events = append(events, inputEvent{Type: EvRel, Code: RelX, Value: int32(countsX)})
+	}
+	if countsY != 0 {
+		events = append(events, inputEvent{Type: EvRel, Code: RelY, Value: int32(countsY)})
+	}
+	if len(events) > 0 {
+		if err := sendEvents(deviceFile, events); err != nil {
+			return fmt.Errorf("failed to move pointer: %v", err)
+		}
+	}
+	m.x, m.y = x-countsX, y-countsY
+	return nil
+}
+
+// high-resolution wheel units per notch, as defined by the kernel
+const wheelUnitsPerNotch = 120
+
+// the most notches a single wheel event can move, so the high-resolution value still fits an event
+const maxWheelNotches = math.MaxInt32 / wheelUnitsPerNotch
+
+func wheelCodes(horizontal bool) (wheel, hiRes uint16) {
+	if horizontal {
+		return RelHwheel, RelHwheelHiRes
+	}
+	return RelWheel, RelWheelHiRes
+}
 
-	_, err = deviceFile.Write(buf)
+// sendWheelEvent sends a wheel movement by whole notches along with its high-resolution event.
+func sendWheelEvent(deviceFile *os.File, horizontal bool, delta int32) error {
+	if delta > maxWheelNotches || delta < -maxWheelNotches {
+		return fmt.Errorf("wheel movement of %d notches is out of range (at most %d)", delta, maxWheelNotches)
+	}
+	w, hiRes := wheelCodes(horizontal)
+	err := sendEvents(deviceFile, []inputEvent{
+		{Type: EvRel, Code: w, Value: delta},
+		{Type: EvRel, Code: hiRes, Value: delta * wheelUnitsPerNotch},
+	})
 	if err != nil {
-		return fmt.Errorf("failed to write rel event to device file: %v", err)
+		return fmt.Errorf("failed to write wheel event to device file: %v", err)
+	}
+	return nil
+}
+
+// wheelRemainder holds the high-resolution units of both wheels that did not add up to a notch yet.
+type wheelRemainder struct {
+	mu         sync.Mutex
+	vertical   int32
+	horizontal int32
+}
+
+// send sends a high-resolution wheel movement together with the notches it completes.
+func (w *wheelRemainder) send(deviceFile *os.File, horizontal bool, units int32) error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	remainder := &w.vertical
+	if horizontal {
+		remainder = &w.horizontal
+	}
+	// like physical wheels, a change of direction starts a new notch
+	r := *remainder
+	if (r < 0 && units > 0) || (r > 0 && units < 0) {
+		r = 0
+	}
+	// summed up in 64 bits, the remainder plus a large movement may not fit into 32 bits
+	sum := int64(r) + int64(units)
+	notches := int32(sum / wheelUnitsPerNotch)
+	r = int32(sum % wheelUnitsPerNotch)
+
+	code, hiRes := wheelCodes(horizontal)
+	events := []inputEvent{{Type: EvRel, Code: hiRes, Value: units}}
+	if notches != 0 {
+		events = append(events, inputEvent{Type: EvRel, Code: code, Value: notches})
+	}
+	err := sendEvents(deviceFile, events)
+	if err != nil {
+		return fmt.Errorf("failed to write wheel event to device file: %v", err)
+	}
+	*remainder = r
+	return nil
+}
+
+// scroll sends a high-resolution wheel movement in steps of smoothMoveInterval.
+func (w *wheelRemainder) scroll(deviceFile *os.File, horizontal bool, units int32, duration time.Duration, easing Easing, sleep func(time.Duration)) error {
+	if easing == nil {
+		easing = EaseOut
+	}
+	steps := int(duration / smoothMoveInterval)
+	if steps < 1 {
+		steps = 1
+	}
+	interval := duration / time.Duration(steps)
+
+	var sent int32
+	for i := 1; i <= steps; i++ {
+		target := units
+		if i < steps {
+			f, _ := easing.At(float64(i) / float64(steps))
+			target = int32(math.Round(float64(units) * f))
+		}
+		if target != sent {
+			if err := w.send(deviceFile, horizontal, target-sent); err != nil {
+				return err
+			}
+			sent = target
+		}
+		if i < steps {
+			sleep(interval)
+		}
 	}
+	return nil
+}
+
+// time between two steps of a smooth movement (the report interval of a 125 Hz mouse)
+const smoothMoveInterval = 8 * time.Millisecond
+
+// moveSmooth sends the steps of a smooth movement. Every step moves to the rounded position on the
+// curve, so rounding errors don't add up and the last step ends exactly at dx, dy.
+func moveSmooth(deviceFile *os.File, dx, dy int32, duration time.Duration, easing Easing, sleep func(time.Duration)) error {
+	var sentX, sentY int32
+	return smoothSteps(dx, dy, duration, easing, sleep, func(x, y int32) error {
+		var events []inputEvent
+		if x != sentX {
+			events = append(events, inputEvent{Type: EvRel, Code: RelX, Value: x - sentX})
+		}
+		if y != sentY {
+			events = append(events, inputEvent{Type: EvRel, Code: RelY, Value: y - sentY})
+		}
+		if len(events) == 0 {
+			return nil
+		}
+		if err := sendEvents(deviceFile, events); err != nil {
+			return fmt.Errorf("failed to move pointer: %v", err)
+		}
+		sentX, sentY = x, y
+		return nil
+	})
+}
 
-	return syncEvents(deviceFile)
+// smoothSteps splits a movement by dx, dy into steps of smoothMoveInterval and calls step with the
+// position on the curve (relative to the start) every step. The last position is exactly dx, dy.
+func smoothSteps(dx, dy int32, duration time.Duration, easing Easing, sleep func(time.Duration), step func(x, y int32) error) error {
+	if easing == nil {
+		easing = EaseLinear
+	}
+	steps := int(duration / smoothMoveInterval)
+	if steps < 1 {
+		steps = 1
+	}
+	interval := duration / time.Duration(steps)
+
+	for i := 1; i <= steps; i++ {
+		x, y := dx, dy
+		if i < steps {
+			fx, fy := easing.At(float64(i) / float64(steps))
+			x = int32(math.Round(float64(dx) * fx))
+			y = int32(math.Round(float64(dy) * fy))
+		}
+		if err := step(x, y); err != nil {
+			return err
+		}
+		if i < steps {
+			sleep(interval)
+		}
+	}
+	return nil
 }
 
 func assertNotNegative(val int32) error {
@@ -3,8 +3,11 @@ package uinput
 import (
 	"fmt"
 	"io/ioutil"
+	"math"
+	"math/rand"
 	"os"
 	"testing"
+	"time"
 )
 
 // This test confirms that all basic mouse moves are working as expected.
@@ -440,3 +443,231 @@ func TestMouseSyspath(t *testing.T) {
 	}
 	t.Logf("Syspath: %s", sysPath)
 }
+
+func TestMoveSmoothEndsExactlyAtTheDestination(t *testing.T) {
+	for name, easing := range map[string]Easing{
+		"linear":      nil,
+		"ease-in-out": EaseInOut,
+		"bezier":      RandomBezierEasing(rand.New(rand.NewSource(3)), 0.4),
+	} {
+		file := createTestDeviceFile(t)
+		var sleeps []time.Duration
+		err := moveSmooth(file, 333, -101, 100*time.Millisecond, easing, func(d time.Duration) {
+			sleeps = append(sleeps, d)
+		})
+		if err != nil {
+			removeTestDeviceFile(file)
+			t.Fatalf("%s: failed to move. Last error was: %s\n", name, err)
+		}
+
+		var x, y int32
+		for _, ev := range readTestEvents(t, file) {
+			if ev.Code == RelX {
+				x += ev.Value
+			} else {
+				y += ev.Value
+			}
+		}
+		frames := len(readTestFrames(t, file))
+		removeTestDeviceFile(file)
+
+		if x != 333 || y != -101 {
+			t.Fatalf("%s: expected a total movement of (333, -101), got (%d, %d)", name, x, y)
+		}
+		// 100ms are split into 12 steps of 8.33ms
+		if len(sleeps) != 11 || sleeps[0] != 100*time.Millisecond/12 {
+			t.Fatalf("%s: unexpected pauses between the steps: %v", name, sleeps)
+		}
+		if frames < 2 || frames > 12 {
+			t.Fatalf("%s: expected the movement to be split into steps, got %d frames", name, frames)
+		}
+	}
+}
+
+func TestWheelSendsHiResEventsWithTheNotches(t *testing.T) {
+	file := createTestDeviceFile(t)
+	defer removeTestDeviceFile(file)
+	mouse := vMouse{deviceFile: file, state: newInputState(), wheel: &wheelRemainder{}}
+
+	if err := mouse.Wheel(true, -2); err != nil {
+		t.Fatalf("Failed to send wheel event. Last error was: %s\n", err)
+	}
+	assertEvents(t, readTestEvents(t, file), []inputEvent{
+		{Type: EvRel, Code: RelHwheel, Value: -2},
+		{Type: EvRel, Code: RelHwheelHiRes, Value: -240},
+	})
+}
+
+func TestWheelFailsIfHiResValueOverflows(t *testing.T) {
+	file := createTestDeviceFile(t)
+	defer removeTestDeviceFile(file)
+	mouse := vMouse{deviceFile: file, state: newInputState(), wheel: &wheelRemainder{}}
+
+	for _, delta := range []int32{maxWheelNotches + 1, -maxWheelNotches - 1, math.MaxInt32} {
+		if err := mouse.Wheel(false, delta); err == nil {
+			t.Fatalf("Expected a wheel movement of %d notches to fail, but no error was returned.", delta)
+		}
+	}
+	if events := readTestEvents(t, file); len(events) != 0 {
+		t.Fatalf("Expected nothing to be sent, got %v", events)
+	}
+	if err := mouse.Wheel(false, maxWheelNotches); err != nil {
+		t.Fatalf("Failed to send wheel event. Last error was: %s\n", err)
+	}
+	assertEvents(t, readTestEvents(t, file), []inputEvent{
+		{Type: EvRel, Code: RelWheel, Value: maxWheelNotches},
+		{Type: EvRel, Code: RelWheelHiRes, Value: maxWheelNotches * wheelUnitsPerNotch},
+	})
+}
+
+func TestWheelHiResSendsNotchesOfLargeMovements(t *testing.T) {
+	file := createTestDeviceFile(t)
+	defer removeTestDeviceFile(file)
+	mouse := vMouse{deviceFile: file, state: newInputState(), wheel: &wheelRemainder{}}
+
+	for _, units := range []int32{119, math.MaxInt32} {
+		if err := mouse.WheelHiRes(false, units); err != nil {
+			t.Fatalf("Failed to send wheel event. Last error was: %s\n", err)
+		}
+	}
+	assertEvents(t, readTestEvents(t, file), []inputEvent{
+		{Type: EvRel, Code: RelWheelHiRes, Value: 119},
+		{Type: EvRel, Code: RelWheelHiRes, Value: math.MaxInt32},
+		{Type: EvRel, Code: RelWheel, Value: (119 + math.MaxInt32) / wheelUnitsPerNotch},
+	})
+}
+
+func TestWheelHiResSendsNotchesOnceTheyAddUp(t *testing.T) {
+	file := createTestDeviceFile(t)
+	defer removeTestDeviceFile(file)
+	mouse := vMouse{deviceFile: file, state: newInputState(), wheel: &wheelRemainder{}}
+
+	for _, units := range []int32{60, 30, 45, 200, -30} {
+		if err := mouse.WheelHiRes(false, units); err != nil {
+			t.Fatalf("Failed to send wheel event. Last error was: %s\n", err)
+		}
+	}
+	assertEvents(t, readTestEvents(t, file), []inputEvent{
+		{Type: EvRel, Code: RelWheelHiRes, Value: 60},
+		{Type: EvRel, Code: RelWheelHiRes, Value: 30},
+		{Type: EvRel, Code: RelWheelHiRes, Value: 45},
+		{Type: EvRel, Code: RelWheel, Value: 1},
+		{Type: EvRel, Code: RelWheelHiRes, Value: 200},
+		{Type: EvRel, Code: RelWheel, Value: 1},
+		// the direction changed, so the remainder of 95 units is dropped
+		{Type: EvRel, Code: RelWheelHiRes, Value: -30},
+	})
+}
+
+func TestScrollDeceleratesAndSendsAllUnits(t *testing.T) {
+	file := createTestDeviceFile(t)
+	defer removeTestDeviceFile(file)
+
+	w := &wheelRemainder{}
+	var sleeps int
+	err := w.scroll(file, false, 600, 80*time.Millisecond, EaseOutExpo, func(time.Duration) { sleeps++ })
+	if err != nil {
+		t.Fatalf("Failed to scroll. Last error was: %s\n", err)
+	}
+
+	var hiRes, notches int32
+	var steps []int32
+	for _, ev := range readTestEvents(t, file) {
+		switch ev.Code {
+		case RelWheelHiRes:
+			hiRes += ev.Value
+			steps = append(steps, ev.Value)
+		case RelWheel:
+			notches += ev.Value
+		}
+	}
+	if hiRes != 600 || notches != 5 {
+		t.Fatalf("Expected 600 units and 5 notches, got %d units and %d notches", hiRes, notches)
+	}
+	if sleeps != 9 {
+		t.Fatalf("Expected 9 pauses between 10 steps, got %d", sleeps)
+	}
+	if steps[0] <= steps[len(steps)-1] {
+		t.Fatalf("Expected the scroll to slow down, got steps %v", steps)
+	}
+}
+
+func TestMouseExtraButtons(t *testing.T) {
+	file := createTestDeviceFile(t)
+	defer removeTestDeviceFile(file)
+	mouse := vMouse{deviceFile: file, state: newInputState(), buttons: []int{BtnLeft, BtnBack, BtnForward, BtnSide}}
+
+	if err := mouse.Press(BtnBack); err != nil {
+		t.Fatalf("Failed to press the back button. Last error was: %s\n", err)
+	}
+	if !mouse.state.isPressed(BtnBack) {
+		t.Fatalf("Expected the back button to be held down")
+	}
+	if err := mouse.Release(BtnBack); err != nil {
+		t.Fatalf("Failed to release the back button. Last error was: %s\n", err)
+	}
+	if err := mouse.Click(BtnForward, 1); err != nil {
+		t.Fatalf("Failed to click the forward button. Last error was: %s\n", err)
+	}
+	if err := mouse.Click(BtnRight, 1); err == nil {
+		t.Fatalf("Expected clicking a button the mouse doesn't have to fail")
+	}
+
+	assertEvents(t, readTestEvents(t, file), []inputEvent{
+		{Type: EvKey, Code: BtnBack, Value: btnStatePressed},
+		{Type: EvKey, Code: BtnBack, Value: btnStateReleased},
+		{Type: EvKey, Code: BtnForward, Value: btnStatePressed},
+		{Type: EvKey, Code: BtnForward, Value: btnStateReleased},
+	})
+}
+
+func TestMouseCreationFailsOnInvalidButton(t *testing.T) {
+	_, err := CreateMouseWithConfig("/dev/null", []byte("Mouse"), MouseConfig{Buttons: []int{BtnLeft, KeyA}})
+	if err == nil {
+		t.Fatalf("Expected creating a mouse with a keyboard key as button to fail")
+	}
+}
+
+func TestMoveFloatAddsUpSmallMovementsExactly(t *testing.T) {
+	file := createTestDeviceFile(t)
+	defer removeTestDeviceFile(file)
+	mouse := vMouse{deviceFile: file, state: newInputState(), motion: &motionRemainder{}}
+
+	for i := 0; i < 1000; i++ {
+		if err := mouse.MoveFloat(0.3, -0.07); err != nil {
+			t.Fatalf("Failed to move. Last error was: %s\n", err)
+		}
+	}
+	var x, y int32
+	for _, ev := range readTestEvents(t, file) {
+		if ev.Code == RelX {
+			x += ev.Value
+		} else {
+			y += ev.Value
+		}
+	}
+	if x != 300 || y != -70 {
+		t.Fatalf("Expected a total movement of (300, -70), got (%d, %d)", x, y)
+	}
+}
+
+func TestMoveFloatScalesByCountsPerPixel(t *testing.T) {
+	file := createTestDeviceFile(t)
+	defer removeTestDeviceFile(file)
+	mouse := vMouse{deviceFile: file, state: newInputState(), motion: &motionRemainder{scale: 2.5}}
+
+	for _, d := range []float64{1, 1, -0.1} {
+		if err := mouse.MoveFloat(d, 0); err != nil {
+			t.Fatalf("Failed to move. Last error was: %s\n", err)
+		}
+	}
+	if err := mouse.MoveFloat(math.NaN(), 0); err == nil {
+		t.Fatalf("Expected moving by NaN to fail")
+	}
+	// 2.5 counts are rounded to 3, the half count too much is taken from the next movement,
+	// and the last -0.25 counts are kept for later
+	assertEvents(t, readTestEvents(t, file), []inputEvent{
+		{Type: EvRel, Code: RelX, Value: 3},
+		{Type: EvRel, Code: RelX, Value: 2},
+	})
+}
@@ -17,6 +17,10 @@ type MultiTouch interface {
 	//Gets all contacts which can then be manipulated
 	GetContacts() []multiTouchContact
 
+	// ReleaseAll will lift every contact in a single frame.
+	// Close does this as well before the device is destroyed.
+	ReleaseAll() error
+
 	// FetchSyspath will return the syspath to the device file.
 	FetchSyspath() (string, error)
 
@@ -27,6 +31,7 @@ type vMultiTouch struct {
 	name       []byte
 	deviceFile *os.File
 	contacts   []multiTouchContact
+	state      *inputState
 }
 
 // The contact can be described as a finger contacting the surface of the MultiTouch device.
@@ -36,9 +41,29 @@ type multiTouchContact struct {
 	tracking_id int32
 }
 
+// MultiTouchConfig holds the settings of a multitouch device created with CreateMultiTouchWithConfig.
+type MultiTouchConfig struct {
+	// boundaries of the x and y-axis within which the contacts may be moved around
+	MinX, MaxX int32
+	MinY, MaxY int32
+
+	// MaxContacts is the maximum amount of contacts.
+	MaxContacts int32
+
+	// Axes sets the fuzz, flat and resolution of AbsMtPositionX and AbsMtPositionY. A range given
+	// here replaces MinX and MaxX or MinY and MaxY.
+	Axes map[uint16]AbsInfo
+}
+
 // CreateMultiTouch will create a new multitouch device. Note that you will need to define the x and y-axis boundaries
 // (min and max) within which the contacs maybe moved around, as well as the maximum amount of contacts allowed.
 func CreateMultiTouch(path string, name []byte, minX int32, maxX int32, minY int32, maxY int32, maxContacts int32) (MultiTouch, error) {
+	return CreateMultiTouchWithConfig(path, name, MultiTouchConfig{
+		MinX: minX, MaxX: maxX, MinY: minY, MaxY: maxY, MaxContacts: maxContacts})
+}
+
+// CreateMultiTouchWithConfig will create a new multitouch device with the given configuration.
+func CreateMultiTouchWithConfig(path string, name []byte, config MultiTouchConfig) (MultiTouch, error) {
 	err := validateDevicePath(path)
 	if err != nil {
 		return nil, err
@@ -47,13 +72,23 @@ func CreateMultiTouch(path string, name []byte, minX int32, maxX int32, minY int
 	if err != nil {
 		return nil, err
 	}
+	maxContacts := config.MaxContacts
+	axes, err := absAxes(map[uint16]AbsInfo{
+		AbsMtSlot:       {Min: 0, Max: maxContacts},
+		AbsMtTrackingId: {Min: 0, Max: maxContacts},
+		AbsMtPositionX:  {Min: config.MinX, Max: config.MaxX},
+		AbsMtPositionY:  {Min: config.MinY, Max: config.MaxY},
+	}, config.Axes)
+	if err != nil {
+		return nil, err
+	}
 
-	fd, err := createMultiTouch(path, name, minX, maxX, minY, maxY, maxContacts)
+	fd, err := createMultiTouch(path, name, axes)
 	if err != nil {
 		return nil, err
 	}
 
-	var multitouch vMultiTouch = vMultiTouch{name: name, deviceFile: fd}
+	var multitouch vMultiTouch = vMultiTouch{name: name, deviceFile: fd, state: newInputState()}
 
 	for i := int32(0); i < maxContacts; i++ {
 		multitouch.contacts = append(multitouch.contacts, multiTouchContact{slot: i, multitouch: &multitouch})
@@ -70,23 +105,31 @@ func (vMulti vMultiTouch) FetchSyspath() (string, error) {
 	return fetchSyspath(vMulti.deviceFile)
 }
 
+func (vMulti vMultiTouch) pacedFile() *os.File {
+	return vMulti.deviceFile
+}
+
+func (vMulti vMultiTouch) ReleaseAll() error {
+	return releaseAll(vMulti.deviceFile, vMulti.state)
+}
+
 func (vMulti vMultiTouch) Close() error {
-	return closeDevice(vMulti.deviceFile)
+	return releaseAndClose(vMulti.deviceFile, vMulti.state)
 }
 
-func createMultiTouch(path string, name []byte, minX int32, maxX int32, minY int32, maxY int32, maxContacts int32) (fd *os.File, err error) {
+func createMultiTouch(path string, name []byte, axes map[uint16]AbsInfo) (fd *os.File, err error) {
 	deviceFile, err := createDeviceFile(path)
 	if err != nil {
 		return nil, fmt.Errorf("could not create absolute axis input device: %v", err)
 	}
 
-	err = registerDevice(deviceFile, uintptr(evKey))
+	err = registerDevice(deviceFile, uintptr(EvKey))
 	if err != nil {
 		_ = deviceFile.Close()
 		return nil, fmt.Errorf("failed to register key device: %v", err)
 	}
 
-	for _, event := range []int{evBtnTouch} {
+	for _, event := range []int{BtnTouch} {
 		err = ioctl(deviceFile, uiSetKeyBit, uintptr(event))
 		if err != nil {
 			_ = deviceFile.Close()
@@ -94,17 +137,17 @@ func createMultiTouch(path string, name []byte, minX int32, maxX int32, minY int
 		}
 	}
 
-	err = registerDevice(deviceFile, uintptr(evAbs))
+	err = registerDevice(deviceFile, uintptr(EvAbs))
 	if err != nil {
 		_ = deviceFile.Close()
 		return nil, fmt.Errorf("failed to register absolute axis input device: %v", err)
 	}
 
 	for _, event := range []int{
-		absMtSlot,
-		absMtTrackingId,
-		absMtPositionX,
-		absMtPositionY,
+		AbsMtSlot,
+		AbsMtTrackingId,
+		AbsMtPositionX,
+		AbsMtPositionY,
 	} {
 		err = ioctl(deviceFile, uiSetAbsBit, uintptr(event))
 		if err != nil {
@@ -113,28 +156,15 @@ func createMultiTouch(path string, name []byte, minX int32, maxX int32, minY int
 		}
 	}
 
-	var absMin [absSize]int32
-	absMin[absMtPositionX] = minX
-	absMin[absMtPositionY] = minY
-	absMin[absMtTrackingId] = 0x00
-	absMin[absMtSlot] = 0x00
-
-	var absMax [absSize]int32
-	absMax[absMtPositionX] = maxX
-	absMax[absMtPositionY] = maxY
-	absMax[absMtTrackingId] = maxContacts
-	absMax[absMtSlot] = maxContacts
-
-	return createUsbDevice(deviceFile,
+	return createAbsDevice(deviceFile,
 		uinputUserDev{
 			Name: toUinputName(name),
 			ID: inputID{
-				Bustype: busUsb,
+				Bustype: BusUsb,
 				Vendor:  0x0,
 				Product: 0x0,
-				Version: 0},
-			Absmin: absMin,
-			Absmax: absMax})
+				Version: 0}},
+		axes)
 }
 
 // The contact will be held down at the coordinates specified
@@ -142,8 +172,8 @@ func (c multiTouchContact) TouchDownAt(x int32, y int32) error {
 	var events []inputEvent
 
 	events = append(events, inputEvent{
-		Type:  evAbs,
-		Code:  absMtPositionX,
+		Type:  EvAbs,
+		Code:  AbsMtPositionX,
 		Value: x,
 	})
 
@@ -152,34 +182,44 @@ func (c multiTouchContact) TouchDownAt(x int32, y int32) error {
 	}
 
 	events = append(events, inputEvent{
-		Type:  evAbs,
-		Code:  absMtPositionY,
+		Type:  EvAbs,
+		Code:  AbsMtPositionY,
 		Value: y,
 	})
 
 	c.tracking_id = c.slot
 
-	return c.sendAbsEvent(events)
+	err := c.sendAbsEvent(events)
+	if err != nil {
+		return err
+	}
+	c.multitouch.state.setSlot(c.slot, true)
+	return nil
 }
 
 // The contact will be raised off of the surface
 func (c multiTouchContact) TouchUp() error {
 	c.tracking_id = -1
-	return c.sendAbsEvent(nil)
+	err := c.sendAbsEvent(nil)
+	if err != nil {
+		return err
+	}
+	c.multitouch.state.setSlot(c.slot, false)
+	return nil
 }
 
 func (c multiTouchContact) sendAbsEvent(events []inputEvent) error {
 	var ev []inputEvent
 
 	ev = append(ev, inputEvent{
-		Type:  evAbs,
-		Code:  absMtSlot,
+		Type:  EvAbs,
+		Code:  AbsMtSlot,
 		Value: c.slot,
 	})
 
 	ev = append(ev, inputEvent{
-		Type:  evAbs,
-		Code:  absMtTrackingId,
+		Type:  EvAbs,
+		Code:  AbsMtTrackingId,
 		Value: c.tracking_id,
 	})
 
@@ -187,17 +227,9 @@ func (c multiTouchContact) sendAbsEvent(events []inputEvent) error {
 		ev = append(ev, events...)
 	}
 
-	for _, iev := range ev {
-		buf, err := inputEventToBuffer(iev)
-		if err != nil {
-			return fmt.Errorf("writing abs event failed: %v", err)
-		}
-
-		_, err = c.multitouch.deviceFile.Write(buf)
-		if err != nil {
-			return fmt.Errorf("failed to write abs event to device file: %v", err)
-		}
+	err := sendEvents(c.multitouch.deviceFile, ev)
+	if err != nil {
+		return fmt.Errorf("failed to write abs event to device file: %v", err)
 	}
-
-	return syncEvents(c.multitouch.deviceFile)
+	return nil
 }
@@ -0,0 +1,302 @@
+package uinput
+
+import (
+	"fmt"
+	"os"
+	"sync"
+	"time"
+)
+
+// QueuePolicy decides what happens to frames sent while the pacer's queue is full.
+type QueuePolicy int
+
+const (
+	// QueueBlock makes the sending call wait until there is room in the queue.
+	QueueBlock QueuePolicy = iota
+	// QueueDropOldest drops the oldest queued frame that only moves axes. Frames with key or button
+	// events are never dropped, if there is no other frame the sending call waits.
+	QueueDropOldest
+	// QueueCoalesce merges a frame that only moves axes into the last queued frame if that one only
+	// moves axes as well: relative movements are summed up, absolute axes take the latest value.
+	// If the frames can not be merged and the queue is full, the sending call waits.
+	QueueCoalesce
+)
+
+// default number of frames a pacer queues
+const defaultPacingQueueSize = 64
+
+// PacingConfig holds the settings of a pacer started with Pace.
+type PacingConfig struct {
+	// MaxEventsPerSecond limits the number of events (not counting sync events) written per second.
+	// Zero means no limit.
+	MaxEventsPerSecond int
+
+	// MinFrameInterval is the minimum time between two frames.
+	MinFrameInterval time.Duration
+
+	// Policy decides what happens to frames while the queue is full (or, for QueueCoalesce,
+	// while frames are queued at all).
+	Policy QueuePolicy
+
+	// QueueSize is the number of frames that can be queued. Defaults to 64.
+	QueueSize int
+}
+
+// A Pacer queues the frames of a device and writes them at a limited rate.
+type Pacer struct {
+	config     PacingConfig
+	deviceFile *os.File
+
+	mu      sync.Mutex
+	cond    *sync.Cond
+	queue   [][]inputEvent
+	writing bool
+	err     error
+	dropped int
+	// stopping is set by Stop, done is closed once the queue is drained afterwards
+	stopping bool
+	done     chan struct{}
+}
+
+// pacers maps device files to the pacer of the device.
+var pacers = struct {
+	sync.Mutex
+	m map[*os.File]*Pacer
+}{m: map[*os.File]*Pacer{}}
+
+func pacerFor(deviceFile *os.File) *Pacer {
+	pacers.Lock()
+	defer pacers.Unlock()
+	return pacers.m[deviceFile]
+}
+
+// Pace starts pacing the output of the given device (a Keyboard, Mouse, TouchPad, Gamepad,
+// MultiTouch or Dial). From then on frames are queued and written in the background, methods of
+// the device only return an error if writing an earlier frame failed (the new frame is not sent in
+// that case). The pacer runs until Stop is called or the device is closed.
+func Pace(device interface{}, config PacingConfig) (*Pacer, error) {
+	deviceFile, ok := pacedFile(device)
+	if !ok {
+		return nil, fmt.Errorf("failed to pace device: %T is not supported", device)
+	}
+	if config.MaxEventsPerSecond < 0 || config.MinFrameInterval < 0 || config.QueueSize < 0 {
+		return nil, fmt.Errorf("failed to pace device: negative limits are not allowed")
+	}
+
+	pacers.Lock()
+	defer pacers.Unlock()
+	if _, ok := pacers.m[deviceFile]; ok {
+		return nil, fmt.Errorf("failed to pace device: the device is paced already")
+	}
+	p := newPacer(deviceFile, config)
+	pacers.m[deviceFile] = p
+	go p.run()
+	return p, nil
+}
+
+// pacedFile returns the device file the frames of the device are written to.
+func pacedFile(device interface{}) (*os.File, bool) {
+	switch d := device.(type) {
+	case watchable:
+		deviceFile, _ := d.trackedState()
+		return deviceFile, true
+	case interface{ pacedFile() *os.File }:
+		// devices that can't be watched, like the multitouch device and the dial
+		return d.pacedFile(), true
+	}
+	return nil, false
+}
+
+func newPacer(deviceFile *os.File, config PacingConfig) *Pacer {
+	if config.QueueSize == 0 {
+		config.QueueSize = defaultPacingQueueSize
+	}
+	p := &Pacer{config: config, deviceFile: deviceFile, done: make(chan struct{})}
+	p.cond = sync.NewCond(&p.mu)
+	return p
+}
+
+// Flush waits until every queued frame is written and returns the first error that occurred while
+// writing since the last call.
+func (p *Pacer) Flush() error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	for (len(p.queue) > 0 || p.writing) && !p.drained() {
+		p.cond.Wait()
+	}
+	err := p.err
+	p.err = nil
+	return err
+}
+
+// Stop writes the queued frames and stops pacing. Frames sent afterwards are written immediately.
+func (p *Pacer) Stop() error {
+	p.mu.Lock()
+	p.stopping = true
+	p.cond.Broadcast()
+	p.mu.Unlock()
+	<-p.done
+
+	pacers.Lock()
+	if pacers.m[p.deviceFile] == p {
+		delete(pacers.m, p.deviceFile)
+	}
+	pacers.Unlock()
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	err := p.err
+	p.err = nil
+	return err
+}
+
+// Dropped returns the number of frames dropped by QueueDropOldest.
+func (p *Pacer) Dropped() int {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return p.dropped
+}
+
+// drained reports whether the pacer's goroutine has ended. p.mu has to be held.
+func (p *Pacer) drained() bool {
+	select {
+	case <-p.done:
+		return true
+	default:
+		return false
+	}
+}
+
+// submit queues a frame according to the queue policy.
+func (p *Pacer) submit(events []inputEvent) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.drained() {
+		return writeEvents(p.deviceFile, events)
+	}
+	if err := p.err; err != nil {
+		p.err = nil
+		return err
+	}
+
+	frame := make([]inputEvent, len(events))
+	copy(frame, events)
+
+	if p.config.Policy == QueueCoalesce && len(p.queue) > 0 && coalesceFrames(p.queue[len(p.queue)-1], frame) {
+		p.queue[len(p.queue)-1] = mergeFrames(p.queue[len(p.queue)-1], frame)
+		return nil
+	}
+	for len(p.queue) >= p.config.QueueSize {
+		if p.config.Policy == QueueDropOldest && p.dropOldest() {
+			break
+		}
+		if p.drained() {
+			return writeEvents(p.deviceFile, events)
+		}
+		p.cond.Wait()
+	}
+	p.queue = append(p.queue, frame)
+	p.cond.Broadcast()
+	return nil
+}
+
+// dropOldest removes the oldest queued frame that only moves axes. p.mu has to be held.
+func (p *Pacer) dropOldest() bool {
+	for i, frame := range p.queue {
+		if axisOnlyFrame(frame) {
+			p.queue = append(p.queue[:i], p.queue[i+1:]...)
+			p.dropped++
+			return true
+		}
+	}
+	return false
+}
+
+func (p *Pacer) run() {
+	var next time.Time
+	for {
+		p.mu.Lock()
+		for len(p.queue) == 0 && !p.stopping {
+			p.cond.Wait()
+		}
+		if len(p.queue) == 0 {
+			close(p.done)
+			p.cond.Broadcast()
+			p.mu.Unlock()
+			return
+		}
+		frame := p.queue[0]
+		p.queue = p.queue[1:]
+		p.writing = true
+		p.cond.Broadcast()
+		p.mu.Unlock()
+
+		if wait := time.Until(next); wait > 0 {
+			time.Sleep(wait)
+		}
+		err := writeEvents(p.deviceFile, frame)
+		next = time.Now().Add(p.frameInterval(len(frame)))
+
+		p.mu.Lock()
+		p.writing = false
+		if err != nil && p.err == nil {
+			p.err = err
+		}
+		p.cond.Broadcast()
+		p.mu.Unlock()
+	}
+}
+
+// frameInterval returns the time to wait after a frame with the given number of events.
+func (p *Pacer) frameInterval(events int) time.Duration {
+	interval := p.config.MinFrameInterval
+	if p.config.MaxEventsPerSecond > 0 {
+		if d := time.Duration(events) * time.Second / time.Duration(p.config.MaxEventsPerSecond); d > interval {
+			interval = d
+		}
+	}
+	return interval
+}
+
+// axisOnlyFrame reports whether the frame only moves axes (multitouch slots excluded), so it can be
+// merged or dropped without losing a press or release.
+func axisOnlyFrame(frame []inputEvent) bool {
+	for _, ev := range frame {
+		switch {
+		case ev.Type == EvRel:
+		case ev.Type == EvAbs && ev.Code < AbsMtSlot:
+		default:
+			return false
+		}
+	}
+	return true
+}
+
+// coalesceFrames reports whether the frames can be merged.
+func coalesceFrames(queued, frame []inputEvent) bool {
+	return axisOnlyFrame(queued) && axisOnlyFrame(frame)
+}
+
+// mergeFrames merges frame into queued: relative movements of the same axis are summed up, absolute
+// axes take the latest value.
+func mergeFrames(queued, frame []inputEvent) []inputEvent {
+	for _, ev := range frame {
+		merged := false
+		for i := range queued {
+			if queued[i].Type != ev.Type || queued[i].Code != ev.Code {
+				continue
+			}
+			if ev.Type == EvRel {
+				queued[i].Value += ev.Value
+			} else {
+				queued[i].Value = ev.Value
+			}
+			merged = true
+			break
+		}
+		if !merged {
+			queued = append(queued, ev)
+		}
+	}
+	return queued
+}
@@ -0,0 +1,145 @@
+package uinput
+
+import (
+	"testing"
+	"time"
+)
+
+func relFrame(code uint16, value int32) []inputEvent {
+	return []inputEvent{{Type: EvRel, Code: code, Value: value}}
+}
+
+func TestPacerCoalescesAxisUpdates(t *testing.T) {
+	p := newPacer(nil, PacingConfig{Policy: QueueCoalesce})
+
+	for _, frame := range [][]inputEvent{
+		relFrame(RelX, 3),
+		relFrame(RelX, 4),
+		{{Type: EvRel, Code: RelY, Value: -2}, {Type: EvAbs, Code: AbsRx, Value: 100}},
+		{{Type: EvAbs, Code: AbsRx, Value: 200}},
+		{{Type: EvKey, Code: BtnLeft, Value: btnStatePressed}},
+		relFrame(RelX, 1),
+	} {
+		if err := p.submit(frame); err != nil {
+			t.Fatalf("Failed to submit frame. Last error was: %s\n", err)
+		}
+	}
+
+	if len(p.queue) != 3 {
+		t.Fatalf("Expected 3 queued frames, got %v", p.queue)
+	}
+	assertEvents(t, p.queue[0], []inputEvent{
+		{Type: EvRel, Code: RelX, Value: 7},
+		{Type: EvRel, Code: RelY, Value: -2},
+		{Type: EvAbs, Code: AbsRx, Value: 200},
+	})
+	assertEvents(t, p.queue[1], []inputEvent{{Type: EvKey, Code: BtnLeft, Value: btnStatePressed}})
+	assertEvents(t, p.queue[2], relFrame(RelX, 1))
+}
+
+func TestPacerDropsOldestAxisFrame(t *testing.T) {
+	p := newPacer(nil, PacingConfig{Policy: QueueDropOldest, QueueSize: 2})
+
+	for _, frame := range [][]inputEvent{
+		{{Type: EvKey, Code: BtnLeft, Value: btnStatePressed}},
+		relFrame(RelX, 1),
+		relFrame(RelX, 2),
+	} {
+		if err := p.submit(frame); err != nil {
+			t.Fatalf("Failed to submit frame. Last error was: %s\n", err)
+		}
+	}
+
+	if len(p.queue) != 2 || p.Dropped() != 1 {
+		t.Fatalf("Expected 2 queued frames and 1 dropped frame, got %v and %d", p.queue, p.Dropped())
+	}
+	assertEvents(t, p.queue[0], []inputEvent{{Type: EvKey, Code: BtnLeft, Value: btnStatePressed}})
+	assertEvents(t, p.queue[1], relFrame(RelX, 2))
+}
+
+func TestPacedDeviceKeepsMinimumFrameInterval(t *testing.T) {
+	vk := createTestKeyboard(t, KeyboardConfig{})
+	defer removeTestDeviceFile(vk.deviceFile)
+
+	p, err := Pace(vk, PacingConfig{MinFrameInterval: 20 * time.Millisecond})
+	if err != nil {
+		t.Fatalf("Failed to pace device. Last error was: %s\n", err)
+	}
+	defer p.Stop()
+	if _, err := Pace(vk, PacingConfig{}); err == nil {
+		t.Fatalf("Expected pacing a paced device to fail, but no error was returned.")
+	}
+
+	start := time.Now()
+	for _, key := range []int{KeyA, KeyB} {
+		if err := vk.KeyPress(key); err != nil {
+			t.Fatalf("Failed to press key. Last error was: %s\n", err)
+		}
+	}
+	if err := p.Flush(); err != nil {
+		t.Fatalf("Failed to flush. Last error was: %s\n", err)
+	}
+
+	// four frames, so at least three intervals
+	if elapsed := time.Since(start); elapsed < 60*time.Millisecond {
+		t.Fatalf("Expected the frames to take at least 60ms, took %v", elapsed)
+	}
+	expected := []inputEvent{
+		{Type: EvKey, Code: KeyA, Value: btnStatePressed},
+		{Type: EvKey, Code: KeyA, Value: btnStateReleased},
+		{Type: EvKey, Code: KeyB, Value: btnStatePressed},
+		{Type: EvKey, Code: KeyB, Value: btnStateReleased},
+	}
+	assertEvents(t, readTestEvents(t, vk.deviceFile), expected)
+
+	if err := p.Stop(); err != nil {
+		t.Fatalf("Failed to stop pacer. Last error was: %s\n", err)
+	}
+	if pacerFor(vk.deviceFile) != nil {
+		t.Fatalf("Expected the pacer to be detached from the device")
+	}
+}
+
+func TestPacerFrameIntervalFollowsEventRate(t *testing.T) {
+	p := newPacer(nil, PacingConfig{MaxEventsPerSecond: 100, MinFrameInterval: 5 * time.Millisecond})
+	if d := p.frameInterval(2); d != 20*time.Millisecond {
+		t.Fatalf("Expected 20ms for 2 events at 100 events per second, got %v", d)
+	}
+	p.config.MinFrameInterval = 50 * time.Millisecond
+	if d := p.frameInterval(2); d != 50*time.Millisecond {
+		t.Fatalf("Expected the minimum frame interval of 50ms, got %v", d)
+	}
+}
+
+func TestPaceSupportsDialAndMultiTouch(t *testing.T) {
+	dial := vDial{name: []byte("Test Dial"), deviceFile: createTestDeviceFile(t)}
+	defer removeTestDeviceFile(dial.deviceFile)
+	multitouch := vMultiTouch{name: []byte("Test MultiTouch"), deviceFile: createTestDeviceFile(t), state: newInputState()}
+	defer removeTestDeviceFile(multitouch.deviceFile)
+
+	for _, device := range []interface{}{dial, multitouch} {
+		p, err := Pace(device, PacingConfig{MinFrameInterval: time.Millisecond})
+		if err != nil {
+			t.Fatalf("Failed to pace %T. Last error was: %s\n", device, err)
+		}
+		if err := p.Stop(); err != nil {
+			t.Fatalf("Failed to stop pacer. Last error was: %s\n", err)
+		}
+	}
+
+	p, err := Pace(dial, PacingConfig{})
+	if err != nil {
+		t.Fatalf("Failed to pace dial. Last error was: %s\n", err)
+	}
+	if err := dial.Turn(3); err != nil {
+		t.Fatalf("Failed to turn dial. Last error was: %s\n", err)
+	}
+	if err := p.Stop(); err != nil {
+		t.Fatalf("Failed to stop pacer. Last error was: %s\n", err)
+	}
+	assertEvents(t, readTestEvents(t, dial.deviceFile), []inputEvent{{Type: EvRel, Code: RelDial, Value: 3}})
+
+	if _, err := Pace(struct{}{}, PacingConfig{}); err == nil {
+		t.Fatalf("Expected pacing an unknown device to fail, but no error was returned.")
+	}
+}
@@ -0,0 +1,163 @@
+package uinput
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+	"sort"
+	"strings"
+	"sync"
+	"syscall"
+)
+
+// A DeviceHandle identifies a device owned by a Registry.
+type DeviceHandle int
+
+// DeviceInfo describes a device owned by a Registry.
+type DeviceInfo struct {
+	Handle DeviceHandle
+	Label  string
+	Device io.Closer
+}
+
+// A Registry owns devices created by the Create* functions and closes them (releasing everything
+// they hold down) when asked to, when a context is done or when the process receives a signal.
+// It is safe for concurrent use.
+type Registry struct {
+	mu      sync.Mutex
+	next    DeviceHandle
+	devices map[DeviceHandle]DeviceInfo
+}
+
+// NewRegistry creates an empty registry.
+func NewRegistry() *Registry {
+	return &Registry{devices: map[DeviceHandle]DeviceInfo{}}
+}
+
+// Add hands the device over to the registry and returns its handle.
+func (r *Registry) Add(label string, device io.Closer) DeviceHandle {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.next++
+	r.devices[r.next] = DeviceInfo{Handle: r.next, Label: label, Device: device}
+	return r.next
+}
+
+// Get returns the device with the given handle.
+func (r *Registry) Get(handle DeviceHandle) (io.Closer, bool) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	info, ok := r.devices[handle]
+	return info.Device, ok
+}
+
+// Devices lists the devices owned by the registry, in the order they were added.
+func (r *Registry) Devices() []DeviceInfo {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	devices := make([]DeviceInfo, 0, len(r.devices))
+	for _, info := range r.devices {
+		devices = append(devices, info)
+	}
+	sort.Slice(devices, func(i, j int) bool { return devices[i].Handle < devices[j].Handle })
+	return devices
+}
+
+// Close closes the device with the given handle and removes it from the registry.
+func (r *Registry) Close(handle DeviceHandle) error {
+	r.mu.Lock()
+	info, ok := r.devices[handle]
+	delete(r.devices, handle)
+	r.mu.Unlock()
+	if !ok {
+		return fmt.Errorf("no device with handle %d", handle)
+	}
+	err := info.Device.Close()
+	if err != nil {
+		return fmt.Errorf("failed to close device %q: %v", info.Label, err)
+	}
+	return nil
+}
+
+// CloseAll closes every device, most recently added first, and empties the registry. All devices
+// are closed even if closing some of them fails.
+func (r *Registry) CloseAll() error {
+	devices := r.Devices()
+	var failed []string
+	for i := len(devices) - 1; i >= 0; i-- {
+		if err := r.Close(devices[i].Handle); err != nil {
+			failed = append(failed, err.Error())
+		}
+	}
+	if len(failed) > 0 {
+		return fmt.Errorf("failed to close %d devices: %s", len(failed), strings.Join(failed, "; "))
+	}
+	return nil
+}
+
+// CloseWhenDone closes every device once the context is done.
+func (r *Registry) CloseWhenDone(ctx context.Context) {
+	go func() {
+		<-ctx.Done()
+		_ = r.CloseAll()
+	}()
+}
+
+// CloseOnSignal closes every device when the process receives one of the given signals (SIGINT
+// and SIGTERM if none are given). The signal is not raised again: the application's own
+// signal.Notify channels receive it once, as usual, and ending the process is left to them. Their
+// handlers may run while the devices are still being closed. Calling the returned function stops
+// listening for the signals.
+func (r *Registry) CloseOnSignal(signals ...os.Signal) (stop func()) {
+	return r.closeOnSignal(nil, signals...)
+}
+
+// ExitOnSignal closes every device when the process receives one of the given signals (SIGINT
+// and SIGTERM if none are given), then stops listening and raises the signal again, so its default
+// action (usually ending the process) runs. Only use it if the application doesn't receive the
+// signals with signal.Notify itself, those channels would get the signal a second time; use
+// CloseOnSignal then. Calling the returned function stops listening for the signals.
+func (r *Registry) ExitOnSignal(signals ...os.Signal) (stop func()) {
+	return r.closeOnSignal(raiseSignal, signals...)
+}
+
+// closeOnSignal closes every device on one of the signals and passes the signal to raise
+// afterwards, unless raise is nil.
+func (r *Registry) closeOnSignal(raise func(os.Signal), signals ...os.Signal) (stop func()) {
+	if len(signals) == 0 {
+		signals = []os.Signal{syscall.SIGINT, syscall.SIGTERM}
+	}
+	ch := make(chan os.Signal, 1)
+	done := make(chan struct{})
+	signal.Notify(ch, signals...)
+
+	go func() {
+		select {
+		case sig := <-ch:
+			_ = r.CloseAll()
+			// only this registry stops listening, handlers of the application stay in place
+			signal.Stop(ch)
+			if raise != nil {
+				raise(sig)
+			}
+		case <-done:
+			signal.Stop(ch)
+		}
+	}()
+
+	var once sync.Once
+	return func() {
+		once.Do(func() { close(done) })
+	}
+}
+
+// raiseSignal sends the signal to the process again.
+func raiseSignal(sig os.Signal) {
+	s, ok := sig.(syscall.Signal)
+	if !ok {
+		return
+	}
+	_ = syscall.Kill(os.Getpid(), s)
+}
@@ -0,0 +1,127 @@
+package uinput
+
+import (
+	"context"
+	"errors"
+	"os"
+	"os/signal"
+	"syscall"
+	"testing"
+	"time"
+)
+
+// testDevice records whether it was closed.
+type testDevice struct {
+	closed chan struct{}
+	err    error
+}
+
+func newTestDevice(err error) *testDevice {
+	return &testDevice{closed: make(chan struct{}), err: err}
+}
+
+func (d *testDevice) Close() error {
+	close(d.closed)
+	return d.err
+}
+
+func assertClosed(t *testing.T, d *testDevice) {
+	t.Helper()
+	select {
+	case <-d.closed:
+	case <-time.After(time.Second):
+		t.Fatalf("Expected device to be closed")
+	}
+}
+
+func TestRegistryListsAndClosesDevices(t *testing.T) {
+	r := NewRegistry()
+	keyboard := newTestDevice(nil)
+	mouse := newTestDevice(errors.New("broken"))
+	kh := r.Add("keyboard", keyboard)
+	mh := r.Add("mouse", mouse)
+
+	devices := r.Devices()
+	if len(devices) != 2 || devices[0].Handle != kh || devices[0].Label != "keyboard" || devices[1].Handle != mh {
+		t.Fatalf("Unexpected devices: %+v", devices)
+	}
+	if d, ok := r.Get(kh); !ok || d != keyboard {
+		t.Fatalf("Expected to get the keyboard by its handle")
+	}
+
+	err := r.CloseAll()
+	if err == nil {
+		t.Fatalf("Expected CloseAll to report the failing device, but no error was returned.")
+	}
+	assertClosed(t, keyboard)
+	assertClosed(t, mouse)
+	if len(r.Devices()) != 0 {
+		t.Fatalf("Expected the registry to be empty, got %+v", r.Devices())
+	}
+	if err := r.Close(kh); err == nil {
+		t.Fatalf("Expected closing a removed device to fail, but no error was returned.")
+	}
+}
+
+func TestRegistryClosesDevicesWhenContextIsDone(t *testing.T) {
+	r := NewRegistry()
+	device := newTestDevice(nil)
+	r.Add("gamepad", device)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	r.CloseWhenDone(ctx)
+	cancel()
+	assertClosed(t, device)
+}
+
+func TestRegistryExitsOnSignal(t *testing.T) {
+	r := NewRegistry()
+	device := newTestDevice(nil)
+	r.Add("keyboard", device)
+
+	raised := make(chan os.Signal, 1)
+	stop := r.closeOnSignal(func(sig os.Signal) { raised <- sig }, syscall.SIGUSR1)
+	defer stop()
+
+	if err := syscall.Kill(syscall.Getpid(), syscall.SIGUSR1); err != nil {
+		t.Fatalf("Failed to send signal. Last error was: %s\n", err)
+	}
+	assertClosed(t, device)
+	select {
+	case sig := <-raised:
+		if sig != syscall.SIGUSR1 {
+			t.Fatalf("Expected SIGUSR1 to be raised again, got %v", sig)
+		}
+	case <-time.After(time.Second):
+		t.Fatalf("Expected the signal to be raised again")
+	}
+}
+
+func TestRegistryClosesDevicesOnSignalWithoutRaisingItAgain(t *testing.T) {
+	r := NewRegistry()
+	device := newTestDevice(nil)
+	r.Add("keyboard", device)
+
+	// the application's own handler
+	received := make(chan os.Signal, 2)
+	signal.Notify(received, syscall.SIGUSR2)
+	defer signal.Stop(received)
+
+	stop := r.CloseOnSignal(syscall.SIGUSR2)
+	defer stop()
+
+	if err := syscall.Kill(syscall.Getpid(), syscall.SIGUSR2); err != nil {
+		t.Fatalf("Failed to send signal. Last error was: %s\n", err)
+	}
+	assertClosed(t, device)
+	select {
+	case <-received:
+	case <-time.After(time.Second):
+		t.Fatalf("Expected the application to receive the signal")
+	}
+	select {
+	case sig := <-received:
+		t.Fatalf("Expected the application to receive the signal once, got %v again", sig)
+	case <-time.After(100 * time.Millisecond):
+	}
+}
@@ -0,0 +1,80 @@
+package uinput
+
+// USB HID usage pages the scancode tables are taken from
+const (
+	usbUsagePageKeyboard = 0x07
+	usbUsagePageConsumer = 0x0c
+)
+
+// usbKeyboardUsages maps keys to their usage on the keyboard/keypad page, as the kernel's
+// HID driver maps them (see hid_keyboard in drivers/hid/hid-input.c).
+var usbKeyboardUsages = map[int]uint16{
+	KeyA: 0x04, KeyB: 0x05, KeyC: 0x06, KeyD: 0x07, KeyE: 0x08, KeyF: 0x09, KeyG: 0x0a, KeyH: 0x0b,
+	KeyI: 0x0c, KeyJ: 0x0d, KeyK: 0x0e, KeyL: 0x0f, KeyM: 0x10, KeyN: 0x11, KeyO: 0x12, KeyP: 0x13,
+	KeyQ: 0x14, KeyR: 0x15, KeyS: 0x16, KeyT: 0x17, KeyU: 0x18, KeyV: 0x19, KeyW: 0x1a, KeyX: 0x1b,
+	KeyY: 0x1c, KeyZ: 0x1d,
+	Key1: 0x1e, Key2: 0x1f, Key3: 0x20, Key4: 0x21, Key5: 0x22, Key6: 0x23, Key7: 0x24, Key8: 0x25,
+	Key9: 0x26, Key0: 0x27,
+	KeyEnter: 0x28, KeyEsc: 0x29, KeyBackspace: 0x2a, KeyTab: 0x2b, KeySpace: 0x2c, KeyMinus: 0x2d,
+	KeyEqual: 0x2e, KeyLeftbrace: 0x2f, KeyRightbrace: 0x30, KeyBackslash: 0x31, KeySemicolon: 0x33,
+	KeyApostrophe: 0x34, KeyGrave: 0x35, KeyComma: 0x36, KeyDot: 0x37, KeySlash: 0x38, KeyCapslock: 0x39,
+	KeyF1: 0x3a, KeyF2: 0x3b, KeyF3: 0x3c, KeyF4: 0x3d, KeyF5: 0x3e, KeyF6: 0x3f, KeyF7: 0x40,
+	KeyF8: 0x41, KeyF9: 0x42, KeyF10: 0x43, KeyF11: 0x44, KeyF12: 0x45,
+	KeySysrq: 0x46, KeyScrolllock: 0x47, KeyPause: 0x48, KeyInsert: 0x49, KeyHome: 0x4a, KeyPageup: 0x4b,
+	KeyDelete: 0x4c, KeyEnd: 0x4d, KeyPagedown: 0x4e, KeyRight: 0x4f, KeyLeft: 0x50, KeyDown: 0x51,
+	KeyUp: 0x52, KeyNumlock: 0x53, KeyKpslash: 0x54, KeyKpasterisk: 0x55, KeyKpminus: 0x56,
+	KeyKpplus: 0x57, KeyKpenter: 0x58, KeyKp1: 0x59, KeyKp2: 0x5a, KeyKp3: 0x5b, KeyKp4: 0x5c,
+	KeyKp5: 0x5d, KeyKp6: 0x5e, KeyKp7: 0x5f, KeyKp8: 0x60, KeyKp9: 0x61, KeyKp0: 0x62, KeyKpdot: 0x63,
+	Key102Nd: 0x64, KeyCompose: 0x65, KeyPower: 0x66, KeyKpequal: 0x67,
+	KeyF13: 0x68, KeyF14: 0x69, KeyF15: 0x6a, KeyF16: 0x6b, KeyF17: 0x6c, KeyF18: 0x6d, KeyF19: 0x6e,
+	KeyF20: 0x6f, KeyF21: 0x70, KeyF22: 0x71, KeyF23: 0x72, KeyF24: 0x73,
+	KeyOpen: 0x74, KeyHelp: 0x75, KeyProps: 0x76, KeyFront: 0x77, KeyStop: 0x78, KeyAgain: 0x79,
+	KeyUndo: 0x7a, KeyCut: 0x7b, KeyCopy: 0x7c, KeyPaste: 0x7d, KeyFind: 0x7e, KeyMute: 0x7f,
+	KeyVolumeup: 0x80, KeyVolumedown: 0x81, KeyKpcomma: 0x85, KeyRo: 0x87, KeyKatakanahiragana: 0x88,
+	KeyYen: 0x89, KeyHenkan: 0x8a, KeyMuhenkan: 0x8b, KeyKpjpcomma: 0x8c, KeyHangeul: 0x90,
+	KeyHanja: 0x91, KeyKatakana: 0x92, KeyHiragana: 0x93, KeyZenkakuhankaku: 0x94,
+	KeyKpleftparen: 0xb6, KeyKprightparen: 0xb7,
+	KeyLeftctrl: 0xe0, KeyLeftshift: 0xe1, KeyLeftalt: 0xe2, KeyLeftmeta: 0xe3, KeyRightctrl: 0xe4,
+	KeyRightshift: 0xe5, KeyRightalt: 0xe6, KeyRightmeta: 0xe7,
+}
+
+// usbConsumerUsages maps media and application keys to their usage on the consumer page.
+var usbConsumerUsages = map[int]uint16{
+	KeyBrightnessup: 0x6f, KeyBrightnessdown: 0x70,
+	KeyPlaycd: 0xb0, KeyPausecd: 0xb1, KeyFastforward: 0xb3, KeyRewind: 0xb4, KeyNextsong: 0xb5,
+	KeyPrevioussong: 0xb6, KeyStopcd: 0xb7, KeyEjectcd: 0xb8, KeyPlaypause: 0xcd,
+	KeyMute: 0xe2, KeyVolumeup: 0xe9, KeyVolumedown: 0xea,
+	KeyConfig: 0x183, KeyMail: 0x18a, KeyCalc: 0x192, KeyFile: 0x194, KeyWww: 0x196,
+	KeySearch: 0x221, KeyHomepage: 0x223, KeyBack: 0x224, KeyForward: 0x225, KeyStop: 0x226,
+	KeyRefresh: 0x227, KeyBookmarks: 0x22a,
+}
+
+// UsbKeyboardScancodes returns the scancodes a USB keyboard sends for the keys of the HID keyboard
+// usage page (page 0x07), e.g. 0x70004 for KeyA.
+func UsbKeyboardScancodes() map[int]int32 {
+	return usbScancodes(usbUsagePageKeyboard, usbKeyboardUsages, nil)
+}
+
+// UsbConsumerScancodes returns the scancodes a USB keyboard sends for the media and application
+// keys of the HID consumer usage page (page 0x0c), e.g. 0xc00e9 for KeyVolumeup.
+func UsbConsumerScancodes() map[int]int32 {
+	return usbScancodes(usbUsagePageConsumer, usbConsumerUsages, nil)
+}
+
+// UsbScancodes returns the scancodes of both UsbKeyboardScancodes and UsbConsumerScancodes. Keys
+// found on both pages (mute, volume and stop) use the consumer page, like most keyboards do.
+func UsbScancodes() map[int]int32 {
+	scancodes := UsbKeyboardScancodes()
+	return usbScancodes(usbUsagePageConsumer, usbConsumerUsages, scancodes)
+}
+
+// usbScancodes adds the scancodes of the usages on the given page to scancodes.
+func usbScancodes(page int32, usages map[int]uint16, scancodes map[int]int32) map[int]int32 {
+	if scancodes == nil {
+		scancodes = make(map[int]int32, len(usages))
+	}
+	for key, usage := range usages {
+		scancodes[key] = page<<16 | int32(usage)
+	}
+	return scancodes
+}
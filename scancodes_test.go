@@ -0,0 +1,37 @@
+package uinput
+
+import "testing"
+
+func TestUsbScancodes(t *testing.T) {
+	for _, tc := range []struct {
+		name      string
+		scancodes map[int]int32
+		key       int
+		expected  int32
+	}{
+		{"keyboard", UsbKeyboardScancodes(), KeyA, 0x70004},
+		{"keyboard", UsbKeyboardScancodes(), KeyLeftctrl, 0x700e0},
+		{"keyboard", UsbKeyboardScancodes(), KeyMute, 0x7007f},
+		{"consumer", UsbConsumerScancodes(), KeyVolumeup, 0xc00e9},
+		{"combined", UsbScancodes(), KeyEnter, 0x70028},
+		{"combined", UsbScancodes(), KeyMute, 0xc00e2},
+	} {
+		scancode, ok := tc.scancodes[tc.key]
+		if !ok {
+			t.Fatalf("%s table has no scancode for %s", tc.name, KeyName(tc.key))
+		}
+		if scancode != tc.expected {
+			t.Fatalf("%s table, %s\nExpected: %#x\nActual: %#x", tc.name, KeyName(tc.key), tc.expected, scancode)
+		}
+	}
+}
+
+func TestUsbScancodesAreUnique(t *testing.T) {
+	keys := map[int32]int{}
+	for key, scancode := range UsbScancodes() {
+		if other, ok := keys[scancode]; ok {
+			t.Fatalf("%s and %s share scancode %#x", KeyName(key), KeyName(other), scancode)
+		}
+		keys[scancode] = key
+	}
+}
@@ -0,0 +1,129 @@
+package uinput
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// A ShortcutStep is a single step of a shortcut: either a chord of keys that are pressed in order and
+// released in reverse order, or a pause.
+type ShortcutStep struct {
+	// Keys of the chord, the last one is usually the key and the others are its modifiers.
+	// Empty for a pause.
+	Keys []int
+
+	// Delay is the length of a pause.
+	Delay time.Duration
+}
+
+// keyAliases maps the common names of keys used in shortcuts to their kernel names.
+var keyAliases = map[string]string{
+	"ctrl":        "KEY_LEFTCTRL",
+	"control":     "KEY_LEFTCTRL",
+	"lctrl":       "KEY_LEFTCTRL",
+	"rctrl":       "KEY_RIGHTCTRL",
+	"shift":       "KEY_LEFTSHIFT",
+	"lshift":      "KEY_LEFTSHIFT",
+	"rshift":      "KEY_RIGHTSHIFT",
+	"alt":         "KEY_LEFTALT",
+	"lalt":        "KEY_LEFTALT",
+	"ralt":        "KEY_RIGHTALT",
+	"altgr":       "KEY_RIGHTALT",
+	"super":       "KEY_LEFTMETA",
+	"meta":        "KEY_LEFTMETA",
+	"win":         "KEY_LEFTMETA",
+	"windows":     "KEY_LEFTMETA",
+	"cmd":         "KEY_LEFTMETA",
+	"esc":         "KEY_ESC",
+	"escape":      "KEY_ESC",
+	"return":      "KEY_ENTER",
+	"del":         "KEY_DELETE",
+	"ins":         "KEY_INSERT",
+	"pgup":        "KEY_PAGEUP",
+	"pgdn":        "KEY_PAGEDOWN",
+	"pagedown":    "KEY_PAGEDOWN",
+	"bksp":        "KEY_BACKSPACE",
+	"caps":        "KEY_CAPSLOCK",
+	"prtsc":       "KEY_SYSRQ",
+	"printscreen": "KEY_SYSRQ",
+	"menu":        "KEY_COMPOSE",
+	"plus":        "KEY_EQUAL",
+	"comma":       "KEY_COMMA",
+}
+
+// ParseShortcutKey parses the name of a single key of a shortcut. Besides the names accepted by
+// ParseKey, short names like "a", "1", "f5" or "enter" and the common aliases of modifiers
+// (ctrl/control, shift, alt, altgr, super/meta/win) are accepted. Names are case insensitive.
+func ParseShortcutKey(name string) (int, error) {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return 0, fmt.Errorf("empty key name")
+	}
+	if alias, ok := keyAliases[strings.ToLower(name)]; ok {
+		return ParseKey(alias)
+	}
+	if key, err := ParseKey("KEY_" + strings.ToUpper(name)); err == nil {
+		return key, nil
+	}
+	key, err := ParseKey(name)
+	if err != nil {
+		return 0, fmt.Errorf("unknown key %q", name)
+	}
+	return key, nil
+}
+
+// ParseShortcut parses a shortcut like "ctrl+alt+t" or "super+1, 200ms, enter". Chords are separated
+// by commas, the keys of a chord by "+". Durations with a unit (see time.ParseDuration) are pauses,
+// so a lone "0" is the key. Every argument may hold one or more steps, so
+// []string{"ctrl+c", "100ms", "ctrl+v"} can be passed as well.
+func ParseShortcut(shortcut ...string) ([]ShortcutStep, error) {
+	var steps []ShortcutStep
+	for _, s := range shortcut {
+		for _, step := range strings.Split(s, ",") {
+			step = strings.TrimSpace(step)
+			if step == "" {
+				return nil, fmt.Errorf("empty step in shortcut %q", s)
+			}
+			if d, ok := parsePause(step); ok {
+				steps = append(steps, ShortcutStep{Delay: d})
+				continue
+			}
+			var keys []int
+			for _, name := range strings.Split(step, "+") {
+				key, err := ParseShortcutKey(name)
+				if err != nil {
+					return nil, fmt.Errorf("invalid chord %q: %v", step, err)
+				}
+				keys = append(keys, key)
+			}
+			steps = append(steps, ShortcutStep{Keys: keys})
+		}
+	}
+	return steps, nil
+}
+
+// parsePause parses a step as a pause. Only durations with a unit count, so "0" is the key.
+func parsePause(step string) (time.Duration, bool) {
+	last := step[len(step)-1]
+	if last < 'a' || last > 'z' {
+		return 0, false
+	}
+	d, err := time.ParseDuration(step)
+	return d, err == nil
+}
+
+// pressShortcut performs the steps of a shortcut on the keyboard.
+func pressShortcut(kb Keyboard, steps []ShortcutStep) error {
+	for _, step := range steps {
+		if len(step.Keys) == 0 {
+			time.Sleep(step.Delay)
+			continue
+		}
+		last := len(step.Keys) - 1
+		if err := pressChord(kb, step.Keys[:last], step.Keys[last]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
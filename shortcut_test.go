@@ -0,0 +1,123 @@
+package uinput
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParseShortcutKeyResolvesAliases(t *testing.T) {
+	for name, expected := range map[string]int{
+		"ctrl":        KeyLeftctrl,
+		"Control":     KeyLeftctrl,
+		"super":       KeyLeftmeta,
+		"meta":        KeyLeftmeta,
+		"win":         KeyLeftmeta,
+		"esc":         KeyEsc,
+		"enter":       KeyEnter,
+		"a":           KeyA,
+		"1":           Key1,
+		"F5":          KeyF5,
+		"KEY_TAB":     KeyTab,
+		"BTN_LEFT":    BtnLeft,
+		"KeyVolumeup": KeyVolumeup,
+	} {
+		key, err := ParseShortcutKey(name)
+		if err != nil {
+			t.Fatalf("Failed to parse %s. Last error was: %s\n", name, err)
+		}
+		if key != expected {
+			t.Fatalf("Parsing %s\nExpected: %s\nActual: %s", name, KeyName(expected), KeyName(key))
+		}
+	}
+}
+
+func TestParseShortcut(t *testing.T) {
+	steps, err := ParseShortcut("super+1, 200ms, enter", "ctrl+shift+esc")
+	if err != nil {
+		t.Fatalf("Failed to parse shortcut. Last error was: %s\n", err)
+	}
+
+	expected := []ShortcutStep{
+		{Keys: []int{KeyLeftmeta, Key1}},
+		{Delay: 200 * time.Millisecond},
+		{Keys: []int{KeyEnter}},
+		{Keys: []int{KeyLeftctrl, KeyLeftshift, KeyEsc}},
+	}
+	if len(steps) != len(expected) {
+		t.Fatalf("Expected: %v\nActual: %v", expected, steps)
+	}
+	for i := range expected {
+		if steps[i].Delay != expected[i].Delay || len(steps[i].Keys) != len(expected[i].Keys) {
+			t.Fatalf("Step %d\nExpected: %v\nActual: %v", i, expected[i], steps[i])
+		}
+		for j := range expected[i].Keys {
+			if steps[i].Keys[j] != expected[i].Keys[j] {
+				t.Fatalf("Step %d\nExpected: %v\nActual: %v", i, expected[i], steps[i])
+			}
+		}
+	}
+}
+
+func TestParseShortcutTreatsBareNumberAsKey(t *testing.T) {
+	steps, err := ParseShortcut("ctrl+c, 0, 0s")
+	if err != nil {
+		t.Fatalf("Failed to parse shortcut. Last error was: %s\n", err)
+	}
+	if len(steps) != 3 {
+		t.Fatalf("Expected 3 steps, got %v", steps)
+	}
+	if len(steps[1].Keys) != 1 || steps[1].Keys[0] != Key0 {
+		t.Fatalf("Expected the step \"0\" to press KEY_0, got %v", steps[1])
+	}
+	if len(steps[2].Keys) != 0 || steps[2].Delay != 0 {
+		t.Fatalf("Expected the step \"0s\" to be a pause, got %v", steps[2])
+	}
+}
+
+func TestParseShortcutFailsOnUnknownKey(t *testing.T) {
+	for _, shortcut := range []string{"ctrl+nokey", "ctrl+", "ctrl+c,,ctrl+v"} {
+		_, err := ParseShortcut(shortcut)
+		if err == nil {
+			t.Fatalf("Expected parsing %q to fail, but got no error.", shortcut)
+		}
+	}
+}
+
+func TestPressShortcutReleasesInReverseOrder(t *testing.T) {
+	vk := createTestKeyboard(t, KeyboardConfig{})
+	defer removeTestDeviceFile(vk.deviceFile)
+
+	err := vk.PressShortcut("ctrl+alt+t")
+	if err != nil {
+		t.Fatalf("Failed to press shortcut. Last error was: %s\n", err)
+	}
+
+	expected := []inputEvent{
+		{Type: EvKey, Code: KeyLeftctrl, Value: 1},
+		{Type: EvKey, Code: KeyLeftalt, Value: 1},
+		{Type: EvKey, Code: KeyT, Value: 1},
+		{Type: EvKey, Code: KeyT, Value: 0},
+		{Type: EvKey, Code: KeyLeftalt, Value: 0},
+		{Type: EvKey, Code: KeyLeftctrl, Value: 0},
+	}
+	assertEvents(t, readTestEvents(t, vk.deviceFile), expected)
+}
+
+func TestKeyChordReleasesModifiersOnError(t *testing.T) {
+	vk := createTestKeyboard(t, KeyboardConfig{})
+	defer removeTestDeviceFile(vk.deviceFile)
+	delete(vk.keys, KeyT)
+
+	err := vk.KeyChord(KeyLeftctrl, KeyLeftalt, KeyT)
+	if err == nil {
+		t.Fatalf("Expected KeyChord to fail due to unregistered key, but got no error.")
+	}
+
+	expected := []inputEvent{
+		{Type: EvKey, Code: KeyLeftctrl, Value: 1},
+		{Type: EvKey, Code: KeyLeftalt, Value: 1},
+		{Type: EvKey, Code: KeyLeftalt, Value: 0},
+		{Type: EvKey, Code: KeyLeftctrl, Value: 0},
+	}
+	assertEvents(t, readTestEvents(t, vk.deviceFile), expected)
+}
@@ -0,0 +1,307 @@
+package uinput
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"sort"
+	"sync"
+	"time"
+)
+
+// NotPressedError is a warning returned when a key or button is released that is not pressed.
+// Nothing is sent to the device in that case. Whether it is returned at all depends on the
+// configuration of the device (see KeyboardConfig.WarnOnUnpressedRelease).
+type NotPressedError struct {
+	Code int
+}
+
+func (e *NotPressedError) Error() string {
+	return fmt.Sprintf("%s is not pressed", KeyName(e.Code))
+}
+
+// errDeviceClosed is returned by operations that would send nothing on a closed device.
+var errDeviceClosed = errors.New("device is closed")
+
+// KeyboardState is a snapshot of the state of a keyboard.
+type KeyboardState struct {
+	// Pressed holds the keys that are held down, in ascending order.
+	Pressed []int
+
+	// CapsLock is the Caps Lock state as tracked by the keyboard.
+	CapsLock bool
+}
+
+// GamepadState is a snapshot of the state of a gamepad.
+type GamepadState struct {
+	// Pressed holds the buttons that are held down, in ascending order.
+	Pressed []int
+
+	// Axes holds the last value sent for every axis (sticks, triggers and hat), in device units.
+	Axes map[uint16]int32
+}
+
+// inputState keeps track of the keys/buttons held down, the axis values and the multitouch
+// contacts (slots) sent by a device, so they can be released when the device is closed.
+// It is safe for concurrent use.
+type inputState struct {
+	// send is held while a frame is sent and the state updated, so frames that depend on the state
+	// (like the releases of the watchdog) don't interleave with the application's frames
+	send sync.Mutex
+
+	mu sync.Mutex
+	// pressed maps the keys held down to the time they were pressed
+	pressed map[int]time.Time
+	axes    map[uint16]int32
+	// neutral holds the rest position of the axes that don't rest at zero
+	neutral map[uint16]int32
+	slots   map[int32]bool
+	closed  bool
+}
+
+func newInputState() *inputState {
+	return &inputState{pressed: map[int]time.Time{}, axes: map[uint16]int32{}, neutral: map[uint16]int32{},
+		slots: map[int32]bool{}}
+}
+
+func (s *inputState) isPressed(key int) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	_, ok := s.pressed[key]
+	return ok
+}
+
+func (s *inputState) setPressed(key int, pressed bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if pressed {
+		if _, ok := s.pressed[key]; !ok {
+			s.pressed[key] = time.Now()
+		}
+	} else {
+		delete(s.pressed, key)
+	}
+}
+
+// pressedKeys returns the keys held down in ascending order.
+func (s *inputState) pressedKeys() []int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	keys := make([]int, 0, len(s.pressed))
+	for key := range s.pressed {
+		keys = append(keys, key)
+	}
+	sort.Ints(keys)
+	return keys
+}
+
+// pressedSince returns the keys held down together with the time they were pressed.
+func (s *inputState) pressedSince() map[int]time.Time {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	pressed := make(map[int]time.Time, len(s.pressed))
+	for key, since := range s.pressed {
+		pressed[key] = since
+	}
+	return pressed
+}
+
+// heldSince reports whether the key is held down since the given time, so it wasn't released and
+// pressed again in between.
+func (s *inputState) heldSince(key int, since time.Time) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	pressedAt, ok := s.pressed[key]
+	return ok && pressedAt.Equal(since)
+}
+
+func (s *inputState) isClosed() bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.closed
+}
+
+func (s *inputState) setAxis(code uint16, value int32) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.axes[code] = value
+}
+
+// setNeutral sets the value an axis is reset to on release.
+func (s *inputState) setNeutral(code uint16, value int32) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.neutral[code] = value
+}
+
+func (s *inputState) axisValue(code uint16) (int32, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	value, ok := s.axes[code]
+	return value, ok
+}
+
+// axisValues returns a copy of the axis values.
+func (s *inputState) axisValues() map[uint16]int32 {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	axes := make(map[uint16]int32, len(s.axes))
+	for code, value := range s.axes {
+		axes[code] = value
+	}
+	return axes
+}
+
+// releaseUnpressed returns the result of releasing a key that is not held down: a warning if
+// requested, an error if the device is closed and nil otherwise.
+func (s *inputState) releaseUnpressed(key int, warn bool) error {
+	if s.isClosed() {
+		return errDeviceClosed
+	}
+	if warn {
+		return &NotPressedError{Code: key}
+	}
+	return nil
+}
+
+func (s *inputState) setSlot(slot int32, active bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if active {
+		s.slots[slot] = true
+	} else {
+		delete(s.slots, slot)
+	}
+}
+
+// releaseEvents returns the events of a frame that lifts every contact, releases every key and
+// resets every axis that is not at its neutral value (zero unless set with setNeutral).
+func (s *inputState) releaseEvents() []inputEvent {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var events []inputEvent
+	slots := make([]int, 0, len(s.slots))
+	for slot := range s.slots {
+		slots = append(slots, int(slot))
+	}
+	sort.Ints(slots)
+	for _, slot := range slots {
+		events = append(events,
+			inputEvent{Type: EvAbs, Code: AbsMtSlot, Value: int32(slot)},
+			inputEvent{Type: EvAbs, Code: AbsMtTrackingId, Value: -1})
+	}
+
+	keys := make([]int, 0, len(s.pressed))
+	for key := range s.pressed {
+		keys = append(keys, key)
+	}
+	sort.Ints(keys)
+	for _, key := range keys {
+		events = append(events, inputEvent{Type: EvKey, Code: uint16(key), Value: btnStateReleased})
+	}
+
+	codes := make([]int, 0, len(s.axes))
+	for code, value := range s.axes {
+		if value != s.neutral[code] {
+			codes = append(codes, int(code))
+		}
+	}
+	sort.Ints(codes)
+	for _, code := range codes {
+		events = append(events, inputEvent{Type: EvAbs, Code: uint16(code), Value: s.neutral[uint16(code)]})
+	}
+	return events
+}
+
+// reset marks everything as released.
+func (s *inputState) reset() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.pressed = map[int]time.Time{}
+	s.slots = map[int32]bool{}
+	for code := range s.axes {
+		s.axes[code] = s.neutral[code]
+	}
+}
+
+// sendTrackedBtnEvent sends a button event and records the new state of the button.
+func sendTrackedBtnEvent(deviceFile *os.File, state *inputState, key int, btnState int) error {
+	state.send.Lock()
+	defer state.send.Unlock()
+	err := sendBtnEvent(deviceFile, []int{key}, btnState)
+	if err != nil {
+		return err
+	}
+	state.setPressed(key, btnState == btnStatePressed)
+	return nil
+}
+
+// releaseTrackedBtnEvent releases a button for the watchdog if it is still held down since the given
+// time. The state is checked under the frame lock, so a button the application released and pressed
+// again in the meantime is left alone. It reports whether the button was still held.
+func releaseTrackedBtnEvent(deviceFile *os.File, state *inputState, key int, since time.Time) (bool, error) {
+	state.send.Lock()
+	defer state.send.Unlock()
+	if !state.heldSince(key, since) {
+		return false, nil
+	}
+	err := sendBtnEvent(deviceFile, []int{key}, btnStateReleased)
+	if err != nil {
+		return true, err
+	}
+	state.setPressed(key, false)
+	return true, nil
+}
+
+// changeTrackedBtnEvent sends a button event unless the button already is in the state the event
+// puts it in. The state is checked under the frame lock, so a watchdog or stream can't change it
+// before the event is sent. It reports whether the event was sent.
+func changeTrackedBtnEvent(deviceFile *os.File, state *inputState, key int, btnState int) (bool, error) {
+	state.send.Lock()
+	defer state.send.Unlock()
+	if state.isPressed(key) == (btnState == btnStatePressed) {
+		return false, nil
+	}
+	err := sendBtnEvent(deviceFile, []int{key}, btnState)
+	if err != nil {
+		return false, err
+	}
+	state.setPressed(key, btnState == btnStatePressed)
+	return true, nil
+}
+
+// releaseAll releases everything held on the device in a single frame.
+func releaseAll(deviceFile *os.File, state *inputState) error {
+	state.send.Lock()
+	defer state.send.Unlock()
+	events := state.releaseEvents()
+	if len(events) == 0 {
+		return nil
+	}
+	err := sendEvents(deviceFile, events)
+	if err != nil {
+		return fmt.Errorf("failed to release held inputs: %v", err)
+	}
+	state.reset()
+	return nil
+}
+
+// releaseAndClose releases everything held on the device before closing it. The device is closed
+// even if the release fails.
+func releaseAndClose(deviceFile *os.File, state *inputState) error {
+	return closeReleased(deviceFile, state, releaseAll(deviceFile, state))
+}
+
+// closeReleased closes a device after its held inputs were released, releaseErr is the result of
+// the release.
+func closeReleased(deviceFile *os.File, state *inputState, releaseErr error) error {
+	state.mu.Lock()
+	state.closed = true
+	state.mu.Unlock()
+	err := closeDevice(deviceFile)
+	if err != nil {
+		return err
+	}
+	return releaseErr
+}
@@ -0,0 +1,66 @@
+package uinput
+
+import "testing"
+
+func TestReleaseEventsCoverEverythingHeld(t *testing.T) {
+	state := newInputState()
+	state.setPressed(BtnLeft, true)
+	state.setPressed(KeyLeftctrl, true)
+	state.setAxis(AbsX, 1000)
+	state.setAxis(AbsY, 0)
+	state.setAxis(AbsHat0X, -1)
+	state.setSlot(1, true)
+
+	expected := []inputEvent{
+		{Type: EvAbs, Code: AbsMtSlot, Value: 1},
+		{Type: EvAbs, Code: AbsMtTrackingId, Value: -1},
+		{Type: EvKey, Code: KeyLeftctrl, Value: btnStateReleased},
+		{Type: EvKey, Code: BtnLeft, Value: btnStateReleased},
+		{Type: EvAbs, Code: AbsX, Value: 0},
+		{Type: EvAbs, Code: AbsHat0X, Value: 0},
+	}
+	assertEvents(t, state.releaseEvents(), expected)
+}
+
+func TestReleaseAllSendsOneFrameAndResetsState(t *testing.T) {
+	file := createTestDeviceFile(t)
+	defer removeTestDeviceFile(file)
+	state := newInputState()
+
+	err := sendTrackedBtnEvent(file, state, BtnLeft, btnStatePressed)
+	if err != nil {
+		t.Fatalf("Failed to press button. Last error was: %s\n", err)
+	}
+	err = releaseAll(file, state)
+	if err != nil {
+		t.Fatalf("Failed to release all. Last error was: %s\n", err)
+	}
+	// nothing is sent if nothing is held
+	err = releaseAll(file, state)
+	if err != nil {
+		t.Fatalf("Failed to release all. Last error was: %s\n", err)
+	}
+
+	if len(state.pressedKeys()) != 0 {
+		t.Fatalf("Expected no buttons to be pressed, got %v", state.pressedKeys())
+	}
+	expected := []inputEvent{
+		{Type: EvKey, Code: BtnLeft, Value: btnStatePressed},
+		{Type: EvKey, Code: BtnLeft, Value: btnStateReleased},
+	}
+	assertEvents(t, readTestEvents(t, file), expected)
+}
+
+func TestReleaseOfUnpressedKeyFailsOnClosedDevice(t *testing.T) {
+	state := newInputState()
+	if err := state.releaseUnpressed(KeyA, false); err != nil {
+		t.Fatalf("Expected no error for an open device, got: %v", err)
+	}
+	if _, ok := state.releaseUnpressed(KeyA, true).(*NotPressedError); !ok {
+		t.Fatalf("Expected a *NotPressedError")
+	}
+	state.closed = true
+	if err := state.releaseUnpressed(KeyA, false); err != errDeviceClosed {
+		t.Fatalf("Expected errDeviceClosed, got: %v", err)
+	}
+}
@@ -0,0 +1,244 @@
+package uinput
+
+import (
+	"fmt"
+	"os"
+	"sort"
+	"sync"
+	"time"
+)
+
+// common polling rates of USB devices, in Hz
+const (
+	PollingRate125  = 125
+	PollingRate250  = 250
+	PollingRate500  = 500
+	PollingRate1000 = 1000
+)
+
+// A Stream emulates the fixed polling rate of a physical device. Instead of sending events, the
+// application sets the state the device should be in, and once per tick a single frame is sent
+// with the buttons and axes that changed since the last one. Relative movements are summed up
+// until the next tick.
+type Stream struct {
+	deviceFile  *os.File
+	state       *inputState
+	denormalize func(code uint16, value float32) int32
+	keys        keyEncoder
+
+	mu      sync.Mutex
+	buttons map[int]bool
+	axes    map[uint16]int32
+	rel     map[uint16]int32
+	err     error
+
+	stop     chan struct{}
+	done     chan struct{}
+	stopOnce sync.Once
+}
+
+// StartStream starts streaming the state of the given device (a Keyboard, Mouse, TouchPad or
+// Gamepad) at the given polling rate in Hz, e.g. PollingRate1000. The stream runs until Stop is
+// called or the device is closed.
+func StartStream(device interface{}, rate int) (*Stream, error) {
+	w, ok := device.(watchable)
+	if !ok {
+		return nil, fmt.Errorf("failed to start stream: %T is not supported", device)
+	}
+	if rate <= 0 || rate > 8000 {
+		return nil, fmt.Errorf("failed to start stream: polling rate %d Hz is out of range", rate)
+	}
+	s := newStream(w)
+	go s.run(time.Second / time.Duration(rate))
+	return s, nil
+}
+
+// keyEncoder is implemented by devices that check their keys and send more than the key event for
+// them, like the keyboard with its scancodes and Caps Lock tracking.
+type keyEncoder interface {
+	checkKey(key int) error
+	keyEvents(key int, btnState int) []inputEvent
+	trackKey(key int, btnState int)
+}
+
+// plainKeys sends bare key events and records them in the state.
+type plainKeys struct {
+	state *inputState
+}
+
+func (p plainKeys) checkKey(key int) error {
+	return nil
+}
+
+func (p plainKeys) keyEvents(key int, btnState int) []inputEvent {
+	return []inputEvent{{Type: EvKey, Code: uint16(key), Value: int32(btnState)}}
+}
+
+func (p plainKeys) trackKey(key int, btnState int) {
+	p.state.setPressed(key, btnState == btnStatePressed)
+}
+
+func newStream(w watchable) *Stream {
+	deviceFile, state := w.trackedState()
+	denormalize := func(code uint16, value float32) int32 { return denormalizeInput(value) }
+	if d, ok := w.(interface {
+		denormalize(code uint16, value float32) int32
+	}); ok {
+		// gamepads with custom axis ranges
+		denormalize = d.denormalize
+	}
+	var keys keyEncoder = plainKeys{state: state}
+	if k, ok := w.(keyEncoder); ok {
+		keys = k
+	}
+	return &Stream{
+		deviceFile:  deviceFile,
+		state:       state,
+		denormalize: denormalize,
+		keys:        keys,
+		buttons:     map[int]bool{},
+		axes:        map[uint16]int32{},
+		rel:         map[uint16]int32{},
+		stop:        make(chan struct{}),
+		done:        make(chan struct{}),
+	}
+}
+
+// SetButton sets whether the key or button should be held down. Keys a keyboard doesn't advertise
+// are rejected.
+func (s *Stream) SetButton(code int, pressed bool) error {
+	if err := s.keys.checkKey(code); err != nil {
+		return fmt.Errorf("failed to set button: %v", err)
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.buttons[code] = pressed
+	return nil
+}
+
+// SetAxis sets the value of an absolute axis, in device units.
+func (s *Stream) SetAxis(code uint16, value int32) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.axes[code] = value
+}
+
+// SetStick sets the value of an absolute axis of a gamepad, in the range of -1 to 1 (see Gamepad).
+func (s *Stream) SetStick(code uint16, value float32) {
+	s.SetAxis(code, s.denormalize(code, value))
+}
+
+// Move adds a relative movement (e.g. RelX or RelWheel) that is sent with the next tick.
+func (s *Stream) Move(code uint16, delta int32) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.rel[code] += delta
+}
+
+// Err returns the first error that occurred while sending a frame.
+func (s *Stream) Err() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.err
+}
+
+// Stop sends the pending changes and stops the stream. It returns the first error that occurred
+// while sending a frame.
+func (s *Stream) Stop() error {
+	s.stopOnce.Do(func() {
+		close(s.stop)
+	})
+	<-s.done
+	return s.Err()
+}
+
+func (s *Stream) run(interval time.Duration) {
+	defer close(s.done)
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-s.stop:
+			s.tick()
+			return
+		case <-ticker.C:
+			if s.state.isClosed() {
+				return
+			}
+			s.tick()
+		}
+	}
+}
+
+// tick sends the changes as a single frame.
+func (s *Stream) tick() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.state.send.Lock()
+	defer s.state.send.Unlock()
+	events := s.changes()
+	if len(events) == 0 {
+		return
+	}
+	err := sendEvents(s.deviceFile, events)
+	if err != nil {
+		if s.err == nil {
+			s.err = err
+		}
+		return
+	}
+	for _, ev := range events {
+		switch ev.Type {
+		case EvKey:
+			s.keys.trackKey(int(ev.Code), int(ev.Value))
+		case EvAbs:
+			s.state.setAxis(ev.Code, ev.Value)
+		}
+	}
+	s.rel = map[uint16]int32{}
+}
+
+// changes returns the events for every button and axis that differs from the state of the device.
+// s.mu has to be held.
+func (s *Stream) changes() []inputEvent {
+	var events []inputEvent
+
+	codes := make([]int, 0, len(s.buttons))
+	for code := range s.buttons {
+		codes = append(codes, code)
+	}
+	sort.Ints(codes)
+	for _, code := range codes {
+		if s.buttons[code] == s.state.isPressed(code) {
+			continue
+		}
+		btnState := btnStateReleased
+		if s.buttons[code] {
+			btnState = btnStatePressed
+		}
+		events = append(events, s.keys.keyEvents(code, btnState)...)
+	}
+
+	for _, code := range sortedAxes(s.axes) {
+		if value, ok := s.state.axisValue(code); ok && value == s.axes[code] {
+			continue
+		}
+		events = append(events, inputEvent{Type: EvAbs, Code: code, Value: s.axes[code]})
+	}
+
+	for _, code := range sortedAxes(s.rel) {
+		if s.rel[code] != 0 {
+			events = append(events, inputEvent{Type: EvRel, Code: code, Value: s.rel[code]})
+		}
+	}
+	return events
+}
+
+func sortedAxes(axes map[uint16]int32) []uint16 {
+	codes := make([]uint16, 0, len(axes))
+	for code := range axes {
+		codes = append(codes, code)
+	}
+	sort.Slice(codes, func(i, j int) bool { return codes[i] < codes[j] })
+	return codes
+}
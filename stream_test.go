@@ -0,0 +1,118 @@
+package uinput
+
+import (
+	"testing"
+	"time"
+)
+
+func TestStreamSendsOnlyChanges(t *testing.T) {
+	file := createTestDeviceFile(t)
+	defer removeTestDeviceFile(file)
+	vg := vGamepad{name: []byte("Test Gamepad"), deviceFile: file, state: newInputState()}
+	s := newStream(vg)
+
+	s.SetButton(ButtonSouth, true)
+	s.SetStick(AbsX, 1)
+	s.SetStick(AbsY, 0.5)
+	s.tick()
+
+	// only the y-axis changes, the button stays pressed
+	s.SetButton(ButtonSouth, true)
+	s.SetStick(AbsX, 1)
+	s.SetStick(AbsY, 0)
+	s.tick()
+
+	// nothing changes, nothing is sent
+	s.tick()
+
+	s.SetButton(ButtonSouth, false)
+	s.tick()
+
+	expected := []inputEvent{
+		{Type: EvKey, Code: ButtonSouth, Value: btnStatePressed},
+		{Type: EvAbs, Code: AbsX, Value: MaximumAxisValue},
+		{Type: EvAbs, Code: AbsY, Value: MaximumAxisValue / 2},
+		{Type: EvAbs, Code: AbsY, Value: 0},
+		{Type: EvKey, Code: ButtonSouth, Value: btnStateReleased},
+	}
+	assertEvents(t, readTestEvents(t, file), expected)
+	if frames := readTestFrames(t, file); len(frames) != 3 {
+		t.Fatalf("Expected 3 frames, got %v", frames)
+	}
+
+	if vg.IsPressed(ButtonSouth) || vg.Snapshot().Axes[AbsX] != MaximumAxisValue {
+		t.Fatalf("Expected the gamepad state to follow the stream, got %+v", vg.Snapshot())
+	}
+}
+
+func TestStreamSumsRelativeMovements(t *testing.T) {
+	file := createTestDeviceFile(t)
+	defer removeTestDeviceFile(file)
+	vm := vMouse{name: []byte("Test Mouse"), deviceFile: file, state: newInputState()}
+	s := newStream(vm)
+
+	s.Move(RelX, 3)
+	s.Move(RelX, 4)
+	s.Move(RelY, -1)
+	s.SetButton(BtnLeft, true)
+	s.tick()
+	s.tick()
+
+	expected := []inputEvent{
+		{Type: EvKey, Code: BtnLeft, Value: btnStatePressed},
+		{Type: EvRel, Code: RelX, Value: 7},
+		{Type: EvRel, Code: RelY, Value: -1},
+	}
+	assertEvents(t, readTestEvents(t, file), expected)
+	if frames := readTestFrames(t, file); len(frames) != 1 {
+		t.Fatalf("Expected 1 frame, got %v", frames)
+	}
+}
+
+func TestStartStreamTicksAtPollingRate(t *testing.T) {
+	vk := createTestKeyboard(t, KeyboardConfig{})
+	defer removeTestDeviceFile(vk.deviceFile)
+
+	if _, err := StartStream(vk, 0); err == nil {
+		t.Fatalf("Expected a polling rate of 0 Hz to fail, but no error was returned.")
+	}
+	s, err := StartStream(vk, PollingRate1000)
+	if err != nil {
+		t.Fatalf("Failed to start stream. Last error was: %s\n", err)
+	}
+	s.SetButton(KeyA, true)
+	time.Sleep(20 * time.Millisecond)
+	if !vk.IsPressed(KeyA) {
+		t.Fatalf("Expected the stream to have pressed KeyA")
+	}
+	s.SetButton(KeyA, false)
+	if err := s.Stop(); err != nil {
+		t.Fatalf("Failed to stop stream. Last error was: %s\n", err)
+	}
+	if vk.IsPressed(KeyA) {
+		t.Fatalf("Expected Stop to send the pending release of KeyA")
+	}
+}
+
+func TestStreamSendsKeysLikeTheKeyboard(t *testing.T) {
+	vk := createTestKeyboard(t, KeyboardConfig{Scancodes: map[int]int32{KeyCapslock: 0x70039}})
+	defer removeTestDeviceFile(vk.deviceFile)
+	vk.keys = map[int]bool{KeyCapslock: true}
+	s := newStream(vk)
+
+	if err := s.SetButton(KeyA, true); err == nil {
+		t.Fatalf("Expected setting a key the keyboard doesn't advertise to fail")
+	}
+	if err := s.SetButton(KeyCapslock, true); err != nil {
+		t.Fatalf("Failed to set button. Last error was: %s\n", err)
+	}
+	s.tick()
+
+	assertEvents(t, readTestEvents(t, vk.deviceFile), []inputEvent{
+		{Type: EvMsc, Code: MscScan, Value: 0x70039},
+		{Type: EvKey, Code: KeyCapslock, Value: btnStatePressed},
+	})
+	if state := vk.Snapshot(); len(state.Pressed) != 1 || !state.CapsLock {
+		t.Fatalf("Expected Caps Lock to be held and on, got %+v", state)
+	}
+}
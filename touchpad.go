@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"io"
 	"os"
+	"time"
 )
 
 // A TouchPad is an input device that uses absolute axis events, meaning that you can specify
@@ -33,12 +34,44 @@ type TouchPad interface {
 	// RightRelease will simulate the release of the right mouse button.
 	RightRelease() error
 
+	// Press will simulate the press of the given button. The button must be one of the buttons of the
+	// touchpad, see TouchPadConfig. It will not be released until Release is invoked.
+	Press(button int) error
+
+	// Release will simulate the release of the given button.
+	Release(button int) error
+
+	// Click will click the given button count times, e.g. 2 for a double click. The button is held
+	// down and the clicks are spaced as configured in TouchPadConfig.Click.
+	Click(button int, count int) error
+
+	// MoveToWithButtons will move the cursor to the specified position and press exactly the given
+	// buttons in a single frame: buttons that are not given are released. This matches the pointer
+	// events of remote desktop protocols, which report the position along with the button mask.
+	MoveToWithButtons(x, y int32, pressed ...int) error
+
+	// Wheel will simulate a wheel movement by the given number of notches. Fails if the touchpad
+	// was created without a wheel, see TouchPadConfig.Wheel.
+	Wheel(horizontal bool, delta int32) error
+
+	// WheelHiRes will simulate a high-resolution wheel movement, 120 units per notch.
+	WheelHiRes(horizontal bool, units int32) error
+
+	// Drag will move the cursor to fromX, fromY, press the button given in the options, move the
+	// cursor to toX, toY and release the button again. The button is released even if the movement fails.
+	Drag(fromX, fromY, toX, toY int32, options DragOptions) error
+
 	// TouchDown will simulate a single touch to a virtual touch device. Use TouchUp to end the touch gesture.
+	// Fails on a touchpad created with TouchPadConfig.NoTouch.
 	TouchDown() error
 
 	// TouchUp will end or ,more precisely, unset the touch event issued by TouchDown
 	TouchUp() error
 
+	// ReleaseAll will release every button and end the touch in a single frame.
+	// Close does this as well before the device is destroyed.
+	ReleaseAll() error
+
 	// FetchSyspath will return the syspath to the device file.
 	FetchSyspath() (string, error)
 
@@ -48,11 +81,61 @@ type TouchPad interface {
 type vTouchPad struct {
 	name       []byte
 	deviceFile *os.File
+	state      *inputState
+	click      ClickTiming
+	buttons    []int
+	wheel      *wheelRemainder
+	noTouch    bool
+}
+
+// TouchPadConfig holds the settings of a touchpad created with CreateTouchPadWithConfig.
+type TouchPadConfig struct {
+	// boundaries of the x and y-axis within which the cursor may be moved around
+	MinX, MaxX int32
+	MinY, MaxY int32
+
+	// Click is the timing of the clicks issued by Click.
+	Click ClickTiming
+
+	// Buttons the touchpad advertises, any of BtnLeft, BtnRight, BtnMiddle, BtnSide, BtnExtra,
+	// BtnForward, BtnBack and BtnTask. Left and right if empty.
+	Buttons []int
+
+	// Wheel adds a vertical and a horizontal wheel with high-resolution events.
+	Wheel bool
+
+	// Axes sets the fuzz, flat and resolution of AbsX and AbsY. A range given here replaces MinX
+	// and MaxX or MinY and MaxY. Without resolution libinput can't tell the physical size of the touchpad.
+	Axes map[uint16]AbsInfo
+
+	// NoTouch leaves out the touch event. Without it the device is an absolute mouse rather than
+	// a touch device, like the tablets of virtual machines and remote desktops.
+	NoTouch bool
+}
+
+// the buttons of a touchpad created with CreateTouchPad
+var touchPadButtons = []int{BtnLeft, BtnRight}
+
+// CreateAbsolutePointer will create a touchpad that works like an absolute mouse: it has all mouse
+// buttons, a vertical and a horizontal wheel and no touch event. Use it for exact positioning, e.g.
+// to forward the pointer of a remote desktop.
+func CreateAbsolutePointer(path string, name []byte, minX int32, maxX int32, minY int32, maxY int32) (TouchPad, error) {
+	return CreateTouchPadWithConfig(path, name, TouchPadConfig{
+		MinX: minX, MaxX: maxX, MinY: minY, MaxY: maxY,
+		Buttons: []int{BtnLeft, BtnRight, BtnMiddle, BtnSide, BtnExtra, BtnForward, BtnBack, BtnTask},
+		Wheel:   true,
+		NoTouch: true,
+	})
 }
 
 // CreateTouchPad will create a new touchpad device. note that you will need to define the x and y-axis boundaries
 // (min and max) within which the cursor maybe moved around.
 func CreateTouchPad(path string, name []byte, minX int32, maxX int32, minY int32, maxY int32) (TouchPad, error) {
+	return CreateTouchPadWithConfig(path, name, TouchPadConfig{MinX: minX, MaxX: maxX, MinY: minY, MaxY: maxY})
+}
+
+// CreateTouchPadWithConfig will create a new touchpad device with the given configuration.
+func CreateTouchPadWithConfig(path string, name []byte, config TouchPadConfig) (TouchPad, error) {
 	err := validateDevicePath(path)
 	if err != nil {
 		return nil, err
@@ -62,12 +145,32 @@ func CreateTouchPad(path string, name []byte, minX int32, maxX int32, minY int32
 		return nil, err
 	}
 
-	fd, err := createTouchPad(path, name, minX, maxX, minY, maxY)
+	buttons := config.Buttons
+	if len(buttons) == 0 {
+		buttons = touchPadButtons
+	}
+	if err = validateMouseButtons(buttons); err != nil {
+		return nil, err
+	}
+	axes, err := absAxes(map[uint16]AbsInfo{
+		AbsX: {Min: config.MinX, Max: config.MaxX},
+		AbsY: {Min: config.MinY, Max: config.MaxY},
+	}, config.Axes)
+	if err != nil {
+		return nil, err
+	}
+
+	fd, err := createTouchPad(path, name, config, buttons, axes)
 	if err != nil {
 		return nil, err
 	}
 
-	return vTouchPad{name: name, deviceFile: fd}, nil
+	var wheel *wheelRemainder
+	if config.Wheel {
+		wheel = &wheelRemainder{}
+	}
+	return vTouchPad{name: name, deviceFile: fd, state: newInputState(), click: config.Click,
+		buttons: buttons, wheel: wheel, noTouch: config.NoTouch}, nil
 }
 
 func (vTouch vTouchPad) MoveTo(x int32, y int32) error {
@@ -75,70 +178,190 @@ func (vTouch vTouchPad) MoveTo(x int32, y int32) error {
 }
 
 func (vTouch vTouchPad) LeftClick() error {
-	err := sendBtnEvent(vTouch.deviceFile, []int{evMouseBtnLeft}, btnStatePressed)
+	err := sendBtnEvent(vTouch.deviceFile, []int{BtnLeft}, btnStatePressed)
 	if err != nil {
 		return fmt.Errorf("failed to issue the LeftClick event: %v", err)
 	}
 
-	return sendBtnEvent(vTouch.deviceFile, []int{evMouseBtnLeft}, btnStateReleased)
+	return sendBtnEvent(vTouch.deviceFile, []int{BtnLeft}, btnStateReleased)
 }
 
 func (vTouch vTouchPad) RightClick() error {
-	err := sendBtnEvent(vTouch.deviceFile, []int{evMouseBtnRight}, btnStatePressed)
+	err := sendBtnEvent(vTouch.deviceFile, []int{BtnRight}, btnStatePressed)
 	if err != nil {
 		return fmt.Errorf("failed to issue the RightClick event: %v", err)
 	}
 
-	return sendBtnEvent(vTouch.deviceFile, []int{evMouseBtnRight}, btnStateReleased)
+	return sendBtnEvent(vTouch.deviceFile, []int{BtnRight}, btnStateReleased)
 }
 
 // LeftPress will simulate a press of the left mouse button. Note that the button will not be released until
 // LeftRelease is invoked.
 func (vTouch vTouchPad) LeftPress() error {
-	return sendBtnEvent(vTouch.deviceFile, []int{evMouseBtnLeft}, btnStatePressed)
+	return sendTrackedBtnEvent(vTouch.deviceFile, vTouch.state, BtnLeft, btnStatePressed)
 }
 
 // LeftRelease will simulate the release of the left mouse button.
 func (vTouch vTouchPad) LeftRelease() error {
-	return sendBtnEvent(vTouch.deviceFile, []int{evMouseBtnLeft}, btnStateReleased)
+	return sendTrackedBtnEvent(vTouch.deviceFile, vTouch.state, BtnLeft, btnStateReleased)
 }
 
 // RightPress will simulate the press of the right mouse button. Note that the button will not be released until
 // RightRelease is invoked.
 func (vTouch vTouchPad) RightPress() error {
-	return sendBtnEvent(vTouch.deviceFile, []int{evMouseBtnRight}, btnStatePressed)
+	return sendTrackedBtnEvent(vTouch.deviceFile, vTouch.state, BtnRight, btnStatePressed)
 }
 
 // RightRelease will simulate the release of the right mouse button.
 func (vTouch vTouchPad) RightRelease() error {
-	return sendBtnEvent(vTouch.deviceFile, []int{evMouseBtnRight}, btnStateReleased)
+	return sendTrackedBtnEvent(vTouch.deviceFile, vTouch.state, BtnRight, btnStateReleased)
+}
+
+// Press will simulate the press of the given button.
+func (vTouch vTouchPad) Press(button int) error {
+	if err := vTouch.assertButton(button); err != nil {
+		return err
+	}
+	return sendTrackedBtnEvent(vTouch.deviceFile, vTouch.state, button, btnStatePressed)
+}
+
+// Release will simulate the release of the given button.
+func (vTouch vTouchPad) Release(button int) error {
+	if err := vTouch.assertButton(button); err != nil {
+		return err
+	}
+	return sendTrackedBtnEvent(vTouch.deviceFile, vTouch.state, button, btnStateReleased)
+}
+
+// MoveToWithButtons will move the cursor and set the buttons in a single frame.
+func (vTouch vTouchPad) MoveToWithButtons(x, y int32, pressed ...int) error {
+	vTouch.state.send.Lock()
+	defer vTouch.state.send.Unlock()
+	want := make(map[int]bool, len(pressed))
+	for _, button := range pressed {
+		if err := vTouch.assertButton(button); err != nil {
+			return err
+		}
+		want[button] = true
+	}
+
+	events := absEvents(x, y)
+	var changed []int
+	for _, button := range vTouch.deviceButtons() {
+		if vTouch.state.isPressed(button) == want[button] {
+			continue
+		}
+		value := btnStateReleased
+		if want[button] {
+			value = btnStatePressed
+		}
+		events = append(events, inputEvent{Type: EvKey, Code: uint16(button), Value: int32(value)})
+		changed = append(changed, button)
+	}
+	err := sendEvents(vTouch.deviceFile, events)
+	if err != nil {
+		return fmt.Errorf("failed to write pointer event to device file: %v", err)
+	}
+	for _, button := range changed {
+		vTouch.state.setPressed(button, want[button])
+	}
+	return nil
+}
+
+// Wheel will simulate a wheel movement.
+func (vTouch vTouchPad) Wheel(horizontal bool, delta int32) error {
+	if vTouch.wheel == nil {
+		return fmt.Errorf("the touchpad has no wheel")
+	}
+	return sendWheelEvent(vTouch.deviceFile, horizontal, delta)
+}
+
+// WheelHiRes will simulate a high-resolution wheel movement.
+func (vTouch vTouchPad) WheelHiRes(horizontal bool, units int32) error {
+	if vTouch.wheel == nil {
+		return fmt.Errorf("the touchpad has no wheel")
+	}
+	return vTouch.wheel.send(vTouch.deviceFile, horizontal, units)
+}
+
+func (vTouch vTouchPad) deviceButtons() []int {
+	if vTouch.buttons == nil {
+		return touchPadButtons
+	}
+	return vTouch.buttons
+}
+
+// assertButton returns an error if the touchpad doesn't advertise the button.
+func (vTouch vTouchPad) assertButton(button int) error {
+	return assertButton(vTouch.deviceButtons(), button)
+}
+
+// Click will click the given button count times.
+func (vTouch vTouchPad) Click(button int, count int) error {
+	if err := vTouch.assertButton(button); err != nil {
+		return err
+	}
+	return clickButton(vTouch.deviceFile, vTouch.state, button, count, vTouch.click, time.Sleep)
+}
+
+// Drag will move the cursor from one position to another while the button is held down.
+func (vTouch vTouchPad) Drag(fromX, fromY, toX, toY int32, options DragOptions) error {
+	options = options.withDefaults()
+	if err := vTouch.assertButton(options.Button); err != nil {
+		return err
+	}
+	if err := sendAbsEvent(vTouch.deviceFile, fromX, fromY); err != nil {
+		return err
+	}
+	return drag(vTouch.deviceFile, vTouch.state, options, time.Sleep, func() error {
+		return moveSmoothTo(vTouch.deviceFile, fromX, fromY, toX, toY, options.Duration, options.Easing, time.Sleep)
+	})
 }
 
 func (vTouch vTouchPad) TouchDown() error {
-	return sendBtnEvent(vTouch.deviceFile, []int{evBtnTouch}, btnStatePressed)
+	if vTouch.noTouch {
+		return fmt.Errorf("the touchpad has no touch event")
+	}
+	return sendTrackedBtnEvent(vTouch.deviceFile, vTouch.state, BtnTouch, btnStatePressed)
 }
 
 func (vTouch vTouchPad) TouchUp() error {
-	return sendBtnEvent(vTouch.deviceFile, []int{evBtnTouch}, btnStateReleased)
+	return sendTrackedBtnEvent(vTouch.deviceFile, vTouch.state, BtnTouch, btnStateReleased)
+}
+
+// releaseTracked releases a button (or ends the touch) for the watchdog.
+func (vTouch vTouchPad) releaseTracked(code int, since time.Time) (bool, error) {
+	return releaseTrackedBtnEvent(vTouch.deviceFile, vTouch.state, code, since)
+}
+
+func (vTouch vTouchPad) trackedState() (*os.File, *inputState) {
+	return vTouch.deviceFile, vTouch.state
+}
+
+func (vTouch vTouchPad) ReleaseAll() error {
+	return releaseAll(vTouch.deviceFile, vTouch.state)
 }
 
 func (vTouch vTouchPad) Close() error {
-	return closeDevice(vTouch.deviceFile)
+	return releaseAndClose(vTouch.deviceFile, vTouch.state)
 }
 
-func createTouchPad(path string, name []byte, minX int32, maxX int32, minY int32, maxY int32) (fd *os.File, err error) {
+func createTouchPad(path string, name []byte, config TouchPadConfig, buttons []int, axes map[uint16]AbsInfo) (fd *os.File, err error) {
 	deviceFile, err := createDeviceFile(path)
 	if err != nil {
 		return nil, fmt.Errorf("could not create absolute axis input device: %v", err)
 	}
 
-	err = registerDevice(deviceFile, uintptr(evKey))
+	err = registerDevice(deviceFile, uintptr(EvKey))
 	if err != nil {
 		_ = deviceFile.Close()
 		return nil, fmt.Errorf("failed to register key device: %v", err)
 	}
-	// register button events (in order to enable left and right click)
-	for _, event := range []int{evMouseBtnLeft, evMouseBtnRight, evBtnTouch} {
+	// register button events (in order to enable clicks and touch)
+	if !config.NoTouch {
+		buttons = append(buttons[:len(buttons):len(buttons)], BtnTouch)
+	}
+	for _, event := range buttons {
 		err = ioctl(deviceFile, uiSetKeyBit, uintptr(event))
 		if err != nil {
 			_ = deviceFile.Close()
@@ -146,14 +369,29 @@ func createTouchPad(path string, name []byte, minX int32, maxX int32, minY int32
 		}
 	}
 
-	err = registerDevice(deviceFile, uintptr(evAbs))
+	if config.Wheel {
+		err = registerDevice(deviceFile, uintptr(EvRel))
+		if err != nil {
+			_ = deviceFile.Close()
+			return nil, fmt.Errorf("failed to register relative axis input device: %v", err)
+		}
+		for _, event := range []int{RelWheel, RelHwheel, RelWheelHiRes, RelHwheelHiRes} {
+			err = ioctl(deviceFile, uiSetRelBit, uintptr(event))
+			if err != nil {
+				_ = deviceFile.Close()
+				return nil, fmt.Errorf("failed to register wheel event %v: %v", event, err)
+			}
+		}
+	}
+
+	err = registerDevice(deviceFile, uintptr(EvAbs))
 	if err != nil {
 		_ = deviceFile.Close()
 		return nil, fmt.Errorf("failed to register absolute axis input device: %v", err)
 	}
 
 	// register x and y-axis events
-	for _, event := range []int{absX, absY} {
+	for _, event := range []int{AbsX, AbsY} {
 		err = ioctl(deviceFile, uiSetAbsBit, uintptr(event))
 		if err != nil {
 			_ = deviceFile.Close()
@@ -161,30 +399,36 @@ func createTouchPad(path string, name []byte, minX int32, maxX int32, minY int32
 		}
 	}
 
-	var absMin [absSize]int32
-	absMin[absX] = minX
-	absMin[absY] = minY
-
-	var absMax [absSize]int32
-	absMax[absX] = maxX
-	absMax[absY] = maxY
-
-	return createUsbDevice(deviceFile,
+	return createAbsDevice(deviceFile,
 		uinputUserDev{
 			Name: toUinputName(name),
 			ID: inputID{
-				Bustype: busUsb,
+				Bustype: BusUsb,
 				Vendor:  0x4711,
 				Product: 0x0817,
-				Version: 1},
-			Absmin: absMin,
-			Absmax: absMax})
+				Version: 1}},
+		axes)
+}
+
+// moveSmoothTo moves the cursor from one position to another in steps of smoothMoveInterval.
+func moveSmoothTo(deviceFile *os.File, fromX, fromY, toX, toY int32, duration time.Duration, easing Easing, sleep func(time.Duration)) error {
+	return smoothSteps(toX-fromX, toY-fromY, duration, easing, sleep, func(x, y int32) error {
+		return sendAbsEvent(deviceFile, fromX+x, fromY+y)
+	})
 }
 
 func sendAbsEvent(deviceFile *os.File, xPos int32, yPos int32) error { // TODO: Perhaps move this to a more generic function? This conflicts with the gamepad ABS events which only have one value.
-	var ev [2]inputEvent
-	ev[0].Type = evAbs
-	ev[0].Code = absX
+	err := sendEvents(deviceFile, absEvents(xPos, yPos))
+	if err != nil {
+		return fmt.Errorf("failed to write abs event to device file: %v", err)
+	}
+	return nil
+}
+
+func absEvents(xPos int32, yPos int32) []inputEvent {
+	ev := make([]inputEvent, 2)
+	ev[0].Type = EvAbs
+	ev[0].Code = AbsX
 	ev[0].Value = xPos
 
 	// Various tests (using evtest) have shown that positioning on x=0;y=0 doesn't trigger any event and will not move
@@ -195,23 +439,10 @@ func sendAbsEvent(deviceFile *os.File, xPos int32, yPos int32) error { // TODO:
 		yPos--
 	}
 
-	ev[1].Type = evAbs
-	ev[1].Code = absY
+	ev[1].Type = EvAbs
+	ev[1].Code = AbsY
 	ev[1].Value = yPos
-
-	for _, iev := range ev {
-		buf, err := inputEventToBuffer(iev)
-		if err != nil {
-			return fmt.Errorf("writing abs event failed: %v", err)
-		}
-
-		_, err = deviceFile.Write(buf)
-		if err != nil {
-			return fmt.Errorf("failed to write abs event to device file: %v", err)
-		}
-	}
-
-	return syncEvents(deviceFile)
+	return ev
 }
 
 func (vTouch vTouchPad) FetchSyspath() (string, error) {
@@ -282,3 +282,77 @@ func TestTouchPadSyspath(t *testing.T) {
 
 	t.Logf("Syspath: %s", sysPath)
 }
+
+func TestTouchPadMoveToWithButtonsSendsOneFrame(t *testing.T) {
+	file := createTestDeviceFile(t)
+	defer removeTestDeviceFile(file)
+	touchPad := vTouchPad{deviceFile: file, state: newInputState(), buttons: []int{BtnLeft, BtnRight, BtnMiddle}}
+
+	if err := touchPad.MoveToWithButtons(100, 200, BtnLeft, BtnMiddle); err != nil {
+		t.Fatalf("Failed to move and press. Last error was: %s\n", err)
+	}
+	if err := touchPad.MoveToWithButtons(120, 210, BtnMiddle); err != nil {
+		t.Fatalf("Failed to move and release. Last error was: %s\n", err)
+	}
+	if err := touchPad.MoveToWithButtons(0, 0, BtnSide); err == nil {
+		t.Fatalf("Expected pressing a button the touchpad doesn't have to fail")
+	}
+
+	expected := [][]inputEvent{
+		{
+			{Type: EvAbs, Code: AbsX, Value: 100},
+			{Type: EvAbs, Code: AbsY, Value: 200},
+			{Type: EvKey, Code: BtnLeft, Value: btnStatePressed},
+			{Type: EvKey, Code: BtnMiddle, Value: btnStatePressed},
+		},
+		{
+			{Type: EvAbs, Code: AbsX, Value: 120},
+			{Type: EvAbs, Code: AbsY, Value: 210},
+			{Type: EvKey, Code: BtnLeft, Value: btnStateReleased},
+		},
+	}
+	frames := readTestFrames(t, file)
+	if len(frames) != len(expected) {
+		t.Fatalf("Expected %d frames, got %d: %v", len(expected), len(frames), frames)
+	}
+	for i := range expected {
+		assertEvents(t, frames[i], expected[i])
+	}
+	if !touchPad.state.isPressed(BtnMiddle) || touchPad.state.isPressed(BtnLeft) {
+		t.Fatalf("Expected only the middle button to be held down")
+	}
+}
+
+func TestTouchPadWheel(t *testing.T) {
+	file := createTestDeviceFile(t)
+	defer removeTestDeviceFile(file)
+
+	withoutWheel := vTouchPad{deviceFile: file, state: newInputState()}
+	if err := withoutWheel.Wheel(false, 1); err == nil {
+		t.Fatalf("Expected the wheel of a touchpad without wheel to fail")
+	}
+
+	touchPad := vTouchPad{deviceFile: file, state: newInputState(), wheel: &wheelRemainder{}}
+	if err := touchPad.Wheel(false, -1); err != nil {
+		t.Fatalf("Failed to send wheel event. Last error was: %s\n", err)
+	}
+	if err := touchPad.WheelHiRes(true, 150); err != nil {
+		t.Fatalf("Failed to send wheel event. Last error was: %s\n", err)
+	}
+	assertEvents(t, readTestEvents(t, file), []inputEvent{
+		{Type: EvRel, Code: RelWheel, Value: -1},
+		{Type: EvRel, Code: RelWheelHiRes, Value: -120},
+		{Type: EvRel, Code: RelHwheelHiRes, Value: 150},
+		{Type: EvRel, Code: RelHwheel, Value: 1},
+	})
+}
+
+func TestAbsolutePointerHasNoTouch(t *testing.T) {
+	file := createTestDeviceFile(t)
+	defer removeTestDeviceFile(file)
+	touchPad := vTouchPad{deviceFile: file, state: newInputState(), noTouch: true}
+
+	if err := touchPad.TouchDown(); err == nil {
+		t.Fatalf("Expected a touch on a device without touch to fail")
+	}
+}
@@ -132,6 +132,13 @@ func registerDevice(deviceFile *os.File, evType uintptr) error {
 }
 
 func createUsbDevice(deviceFile *os.File, dev uinputUserDev) (fd *os.File, err error) {
+	return createAbsDevice(deviceFile, dev, nil)
+}
+
+// createAbsDevice creates a device with the given absolute axes. Their range, fuzz and flat are
+// written with the device struct, resolutions are set up afterwards.
+func createAbsDevice(deviceFile *os.File, dev uinputUserDev, axes map[uint16]AbsInfo) (fd *os.File, err error) {
+	applyAbsInfo(&dev, axes)
 	buf := new(bytes.Buffer)
 	err = binary.Write(buf, binary.LittleEndian, dev)
 	if err != nil {
@@ -144,6 +151,12 @@ func createUsbDevice(deviceFile *os.File, dev uinputUserDev) (fd *os.File, err e
 		return nil, fmt.Errorf("failed to write uidev struct to device file: %v", err)
 	}
 
+	err = setupAbsResolution(deviceFile, axes)
+	if err != nil {
+		_ = deviceFile.Close()
+		return nil, err
+	}
+
 	err = ioctl(deviceFile, uiDevCreate, uintptr(0))
 	if err != nil {
 		_ = deviceFile.Close()
@@ -156,6 +169,10 @@ func createUsbDevice(deviceFile *os.File, dev uinputUserDev) (fd *os.File, err e
 }
 
 func closeDevice(deviceFile *os.File) (err error) {
+	if p := pacerFor(deviceFile); p != nil {
+		// frames still queued (e.g. the releases sent by Close) are written before the device is destroyed
+		_ = p.Stop()
+	}
 	err = releaseDevice(deviceFile)
 	if err != nil {
 		return fmt.Errorf("failed to close device: %v", err)
@@ -180,21 +197,46 @@ func fetchSyspath(deviceFile *os.File) (string, error) {
 // Note that mice and touch pads do have buttons as well. Therefore, this function is used
 // by all currently available devices and resides in the main source file.
 func sendBtnEvent(deviceFile *os.File, keys []int, btnState int) (err error) {
+	events := make([]inputEvent, 0, len(keys))
 	for _, key := range keys {
-		buf, err := inputEventToBuffer(inputEvent{
+		events = append(events, inputEvent{
 			Time:  syscall.Timeval{Sec: 0, Usec: 0},
-			Type:  evKey,
+			Type:  EvKey,
 			Code:  uint16(key),
 			Value: int32(btnState)})
+	}
+	err = sendEvents(deviceFile, events)
+	if err != nil {
+		return fmt.Errorf("writing btnEvent structure to the device file failed: %v", err)
+	}
+	return nil
+}
+
+// sendEvents sends the given events followed by a sync event, so they are reported as one frame.
+// If the device is paced (see Pace), the frame is handed to the pacer.
+func sendEvents(deviceFile *os.File, events []inputEvent) error {
+	if p := pacerFor(deviceFile); p != nil {
+		return p.submit(events)
+	}
+	return writeEvents(deviceFile, events)
+}
+
+// writeEvents writes the given events followed by a sync event to the device file. The frame is
+// written at once, so frames sent from different goroutines can't interleave.
+func writeEvents(deviceFile *os.File, events []inputEvent) (err error) {
+	frame := make([]byte, 0, (len(events)+1)*24)
+	for _, ev := range append(events[:len(events):len(events)], inputEvent{Type: EvSyn, Code: uint16(SynReport)}) {
+		buf, err := inputEventToBuffer(ev)
 		if err != nil {
-			return fmt.Errorf("key event could not be set: %v", err)
-		}
-		_, err = deviceFile.Write(buf)
-		if err != nil {
-			return fmt.Errorf("writing btnEvent structure to the device file failed: %v", err)
+			return fmt.Errorf("event could not be set: %v", err)
 		}
+		frame = append(frame, buf...)
 	}
-	return syncEvents(deviceFile)
+	_, err = deviceFile.Write(frame)
+	if err != nil {
+		return fmt.Errorf("writing event structure to the device file failed: %v", err)
+	}
+	return nil
 }
 
 // Currently only used for force-feedback support
@@ -298,8 +340,8 @@ func forceFeedbackCallback(deviceFile *os.File, callback func(upload *UInputFFUp
 func syncEvents(deviceFile *os.File) (err error) {
 	buf, err := inputEventToBuffer(inputEvent{
 		Time:  syscall.Timeval{Sec: 0, Usec: 0},
-		Type:  evSyn,
-		Code:  uint16(synReport),
+		Type:  EvSyn,
+		Code:  uint16(SynReport),
 		Value: 0})
 	if err != nil {
 		return fmt.Errorf("writing sync event failed: %v", err)
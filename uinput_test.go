@@ -1,6 +1,7 @@
 package uinput
 
 import (
+	"io/ioutil"
 	"os"
 	"strings"
 	"testing"
@@ -69,3 +70,61 @@ func TestNonExistentDeviceFileCausesError(t *testing.T) {
 		t.Fatalf("got '%v', but expected '%v'", err.Error(), expected)
 	}
 }
+
+// createTestDeviceFile returns a regular file that can stand in for the device file of a virtual device.
+// Everything that is written to it can be read back using readTestEvents. This allows testing the events
+// a device sends without access to /dev/uinput. Use removeTestDeviceFile to clean up.
+func createTestDeviceFile(t *testing.T) *os.File {
+	file, err := ioutil.TempFile(os.TempDir(), "uinput-events-test-")
+	if err != nil {
+		t.Fatalf("Failed to setup test. Unable to create tempfile: %v", err)
+	}
+	return file
+}
+
+func removeTestDeviceFile(file *os.File) {
+	file.Close()
+	os.Remove(file.Name())
+}
+
+// readTestEvents returns all events written to a file created by createTestDeviceFile, without the sync events.
+func readTestEvents(t *testing.T, file *os.File) []inputEvent {
+	buf, err := ioutil.ReadFile(file.Name())
+	if err != nil {
+		t.Fatalf("Failed to read events: %v", err)
+	}
+	var events []inputEvent
+	for i := 0; i+24 <= len(buf); i += 24 {
+		iev, err := inputEventFromBuffer(buf[i : i+24])
+		if err != nil {
+			t.Fatalf("Failed to read events: %v", err)
+		}
+		if iev.Type != EvSyn {
+			events = append(events, *iev)
+		}
+	}
+	return events
+}
+
+// readTestFrames reads the events written to a test device file, split into frames at sync events.
+func readTestFrames(t *testing.T, file *os.File) [][]inputEvent {
+	buf, err := ioutil.ReadFile(file.Name())
+	if err != nil {
+		t.Fatalf("Failed to read events: %v", err)
+	}
+	var frames [][]inputEvent
+	var frame []inputEvent
+	for i := 0; i+24 <= len(buf); i += 24 {
+		iev, err := inputEventFromBuffer(buf[i : i+24])
+		if err != nil {
+			t.Fatalf("Failed to read events: %v", err)
+		}
+		if iev.Type == EvSyn {
+			frames = append(frames, frame)
+			frame = nil
+			continue
+		}
+		frame = append(frame, *iev)
+	}
+	return frames
+}
@@ -11,6 +11,7 @@ const (
 	uiDevCreate       = 0x5501
 	uiDevDestroy      = 0x5502
 	uiDevSetup        = 0x405c5503
+	uiAbsSetup        = 0x401c5504
 	// this is for 64 length buffer to store name
 	// for another length generate using : (len << 16) | 0x8000552C
 	uiGetSysname  = 0x8041552c
@@ -19,54 +20,21 @@ const (
 	uiSetKeyBit   = 0x40045565
 	uiSetRelBit   = 0x40045566
 	uiSetAbsBit   = 0x40045567
+	uiSetMscBit   = 0x40045568
 	uiSetFFBit    = 0x4004556b 
 
   uiBeginFFUpload = 0xc06855c8
   uiEndFFUpload   = 0x406855c9
   uiBeginFFErase  = 0xc00c55ca
   uiEndFFErase    = 0x400c55cb
-  
-	busUsb      = 0x03
 )
 
-// input event codes as specified in input-event-codes.h
-const (
-	evSyn     = 0x00
-	evKey     = 0x01
-	evRel     = 0x02
-	evAbs     = 0x03
-  evFF      = 0x15
-	relX      = 0x0
-	relY      = 0x1
-	relHWheel = 0x6
-	relWheel  = 0x8
-	relDial   = 0x7
-
-	absX     = 0x00
-	absY     = 0x01
-	absZ     = 0x02
-	absRX    = 0x03
-	absRY    = 0x04
-	absRZ    = 0x05
-	absHat0X = 0x10
-	absHat0Y = 0x11
-
-	absMtSlot       = 0x2f
-	absMtTouchMajor = 0x30
-	absMtPositionX  = 0x35
-	absMtPositionY  = 0x36
-	absMtTrackingId = 0x39
-
-	synReport        = 0
-	evMouseBtnLeft   = 0x110
-	evMouseBtnRight  = 0x111
-	evMouseBtnMiddle = 0x112
-	evBtnTouch       = 0x14a
-)
+// the exported event codes (EvKey, AbsX, ...) are generated into eventcodes.go
 
 const (
 	btnStateReleased = 0
 	btnStatePressed  = 1
+	btnStateRepeated = 2
 	absSize          = 64
 )
 
@@ -95,6 +63,23 @@ type uinputUserDev struct {
 	Absflat    [absSize]int32
 }
 
+// translated to go from input.h
+type inputAbsinfo struct {
+	Value      int32
+	Minimum    int32
+	Maximum    int32
+	Fuzz       int32
+	Flat       int32
+	Resolution int32
+}
+
+// translated to go from uinput.h
+type uinputAbsSetup struct {
+	Code    uint16
+	_       uint16
+	Absinfo inputAbsinfo
+}
+
 // translated to go from input.h
 type inputEvent struct {
 	Time  syscall.Timeval
@@ -0,0 +1,199 @@
+package uinput
+
+import (
+	"fmt"
+	"strconv"
+	"unicode"
+)
+
+// A UnicodeFallback types characters the layout of a keyboard can not produce. The fallbacks of a
+// keyboard are set using KeyboardConfig.UnicodeFallbacks, TypeString uses the first one that can
+// type a character. UnicodeHexInput and ComposeInput check that the keyboard can type their whole
+// sequence before anything is typed, other fallbacks are trusted to type what CanType accepts.
+type UnicodeFallback interface {
+	// CanType reports whether the fallback is able to type the rune.
+	CanType(r rune) bool
+
+	// TypeRune types the rune on the given keyboard.
+	TypeRune(kb Keyboard, r rune) error
+}
+
+// UnicodeHexInput types characters by their code point using the Ctrl+Shift+U sequence
+// understood by GTK and IBus: Ctrl+Shift+U, the code point in hex, then the commit key.
+type UnicodeHexInput struct {
+	// CommitKey ends the sequence. Defaults to KeySpace.
+	CommitKey int
+}
+
+// CanType reports whether r is a printable character.
+func (UnicodeHexInput) CanType(r rune) bool {
+	return r > 0 && r <= unicode.MaxRune && !unicode.IsControl(r)
+}
+
+func (h UnicodeHexInput) canTypeOn(vk *vKeyboard, r rune) bool {
+	return h.CanType(r) &&
+		vk.canTypeKeys(KeyLeftctrl, KeyLeftshift, KeyU, h.commitKey()) &&
+		vk.canTypeText(strconv.FormatInt(int64(r), 16))
+}
+
+func (h UnicodeHexInput) commitKey() int {
+	if h.CommitKey == KeyReserved {
+		return KeySpace
+	}
+	return h.CommitKey
+}
+
+// TypeRune types r using the Ctrl+Shift+U sequence.
+func (h UnicodeHexInput) TypeRune(kb Keyboard, r rune) error {
+	err := pressChord(kb, []int{KeyLeftctrl, KeyLeftshift}, KeyU)
+	if err != nil {
+		return fmt.Errorf("failed to start unicode input: %v", err)
+	}
+	err = kb.TypeString(strconv.FormatInt(int64(r), 16))
+	if err != nil {
+		return fmt.Errorf("failed to type code point of %q: %v", r, err)
+	}
+	return kb.KeyPress(h.commitKey())
+}
+
+// ComposeInput types characters using the compose key followed by a sequence of characters,
+// e.g. Compose, o, c for ©. The compose key has to be enabled in the desktop environment.
+type ComposeInput struct {
+	// Key is the key the system uses as compose key. Defaults to KeyCompose (the menu key).
+	Key int
+
+	// Sequences maps characters to the sequence that is typed after the compose key.
+	// Defaults to DefaultComposeSequences.
+	Sequences map[rune]string
+}
+
+// CanType reports whether there is a compose sequence for r.
+func (c ComposeInput) CanType(r rune) bool {
+	_, ok := c.sequences()[r]
+	return ok
+}
+
+func (c ComposeInput) canTypeOn(vk *vKeyboard, r rune) bool {
+	seq, ok := c.sequences()[r]
+	return ok && vk.canTypeKeys(c.composeKey()) && vk.canTypeText(seq)
+}
+
+func (c ComposeInput) composeKey() int {
+	if c.Key == KeyReserved {
+		return KeyCompose
+	}
+	return c.Key
+}
+
+// TypeRune types r using its compose sequence.
+func (c ComposeInput) TypeRune(kb Keyboard, r rune) error {
+	seq, ok := c.sequences()[r]
+	if !ok {
+		return fmt.Errorf("no compose sequence for %q", r)
+	}
+	err := kb.KeyPress(c.composeKey())
+	if err != nil {
+		return fmt.Errorf("failed to press compose key: %v", err)
+	}
+	return kb.TypeString(seq)
+}
+
+func (c ComposeInput) sequences() map[rune]string {
+	if c.Sequences == nil {
+		return defaultComposeSequences
+	}
+	return c.Sequences
+}
+
+// typeChecker is implemented by the fallbacks that can check whether a keyboard is able to type the
+// whole sequence they send for a rune.
+type typeChecker interface {
+	canTypeOn(vk *vKeyboard, r rune) bool
+}
+
+// UnicodeHook is a fallback supplied by the caller.
+type UnicodeHook struct {
+	// Supports reports whether Type can type the rune. If nil, every rune is passed to Type.
+	Supports func(r rune) bool
+
+	// Type types the rune on the given keyboard.
+	Type func(kb Keyboard, r rune) error
+}
+
+// CanType reports whether the hook supports r.
+func (h UnicodeHook) CanType(r rune) bool {
+	if h.Type == nil {
+		return false
+	}
+	return h.Supports == nil || h.Supports(r)
+}
+
+// TypeRune passes r to the hook.
+func (h UnicodeHook) TypeRune(kb Keyboard, r rune) error {
+	return h.Type(kb, r)
+}
+
+// defaultComposeSequences holds the sequences of the default X11 compose table (en_US.UTF-8)
+// for common symbols and accented letters.
+var defaultComposeSequences = buildComposeSequences()
+
+// composeSymbols holds the compose sequences of common symbols.
+var composeSymbols = map[rune]string{
+	'©': "oc", '®': "or", '™': "tm", '€': "=E", '£': "L-", '¥': "Y=", '¢': "c/",
+	'°': "oo", '±': "+-", '×': "xx", '÷': ":-", '¬': ",-", 'µ': "mu", '§': "so", '¶': "P!",
+	'½': "12", '¼': "14", '¾': "34", '¹': "^1", '²': "^2", '³': "^3",
+	'…': "..", '—': "---", '–': "--.", '«': "<<", '»': ">>", '¿': "??", '¡': "!!",
+	'ß': "ss", 'æ': "ae", 'Æ': "AE", 'ø': "o/", 'Ø': "O/", 'å': "oa", 'Å': "OA", 'ç': ",c", 'Ç': ",C",
+}
+
+// composePrefixes maps the combining character of an accent to the character used for it in compose sequences.
+var composePrefixes = map[rune]string{
+	'\u0300': "`",
+	'\u0301': "'",
+	'\u0302': "^",
+	'\u0303': "~",
+	'\u0308': "\"",
+}
+
+func buildComposeSequences() map[rune]string {
+	sequences := map[rune]string{}
+	for r, c := range composedRunes {
+		sequences[r] = composePrefixes[c.accent] + string(c.base)
+	}
+	for r, seq := range composeSymbols {
+		sequences[r] = seq
+	}
+	return sequences
+}
+
+// DefaultComposeSequences returns a copy of the compose sequences used by ComposeInput by default.
+func DefaultComposeSequences() map[rune]string {
+	sequences := make(map[rune]string, len(defaultComposeSequences))
+	for r, seq := range defaultComposeSequences {
+		sequences[r] = seq
+	}
+	return sequences
+}
+
+// pressChord presses key while holding down the modifiers. The modifiers are released in reverse
+// order in any case, except for those that were already held down before.
+func pressChord(kb Keyboard, modifiers []int, key int) (err error) {
+	var pressed []int
+	defer func() {
+		for i := len(pressed) - 1; i >= 0; i-- {
+			if upErr := kb.KeyUp(pressed[i]); upErr != nil && err == nil {
+				err = upErr
+			}
+		}
+	}()
+	for _, m := range modifiers {
+		if kb.IsPressed(m) {
+			continue
+		}
+		if err = kb.KeyDown(m); err != nil {
+			return err
+		}
+		pressed = append(pressed, m)
+	}
+	return kb.KeyPress(key)
+}
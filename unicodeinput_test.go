@@ -0,0 +1,133 @@
+package uinput
+
+import (
+	"testing"
+)
+
+// keyTap returns the events of pressing and releasing a key.
+func keyTap(key int) []inputEvent {
+	return []inputEvent{
+		{Type: EvKey, Code: uint16(key), Value: btnStatePressed},
+		{Type: EvKey, Code: uint16(key), Value: btnStateReleased},
+	}
+}
+
+func keyTaps(keys ...int) []inputEvent {
+	var events []inputEvent
+	for _, key := range keys {
+		events = append(events, keyTap(key)...)
+	}
+	return events
+}
+
+func TestUnicodeHexInputTypesCtrlShiftUSequence(t *testing.T) {
+	vk := createTestKeyboard(t, KeyboardConfig{})
+	defer removeTestDeviceFile(vk.deviceFile)
+	vk.fallbacks = []UnicodeFallback{UnicodeHexInput{}}
+
+	if err := vk.TypeString("☃"); err != nil {
+		t.Fatalf("Failed to type string. Last error was: %s\n", err)
+	}
+
+	expected := []inputEvent{
+		{Type: EvKey, Code: KeyLeftctrl, Value: btnStatePressed},
+		{Type: EvKey, Code: KeyLeftshift, Value: btnStatePressed},
+		{Type: EvKey, Code: KeyU, Value: btnStatePressed},
+		{Type: EvKey, Code: KeyU, Value: btnStateReleased},
+		{Type: EvKey, Code: KeyLeftshift, Value: btnStateReleased},
+		{Type: EvKey, Code: KeyLeftctrl, Value: btnStateReleased},
+	}
+	// U+2603, committed with space
+	expected = append(expected, keyTaps(Key2, Key6, Key0, Key3, KeySpace)...)
+	assertEvents(t, readTestEvents(t, vk.deviceFile), expected)
+}
+
+func TestComposeInputTypesComposeSequence(t *testing.T) {
+	vk := createTestKeyboard(t, KeyboardConfig{})
+	defer removeTestDeviceFile(vk.deviceFile)
+	vk.fallbacks = []UnicodeFallback{ComposeInput{}}
+
+	if err := vk.TypeString("©"); err != nil {
+		t.Fatalf("Failed to type string. Last error was: %s\n", err)
+	}
+	assertEvents(t, readTestEvents(t, vk.deviceFile), keyTaps(KeyCompose, KeyO, KeyC))
+}
+
+func TestUnicodeHookReceivesRunes(t *testing.T) {
+	vk := createTestKeyboard(t, KeyboardConfig{})
+	defer removeTestDeviceFile(vk.deviceFile)
+
+	var typed []rune
+	vk.fallbacks = []UnicodeFallback{UnicodeHook{
+		Supports: func(r rune) bool { return r == '☃' },
+		Type: func(kb Keyboard, r rune) error {
+			typed = append(typed, r)
+			return kb.KeyPress(KeyX)
+		},
+	}}
+
+	if err := vk.TypeString("a☃"); err != nil {
+		t.Fatalf("Failed to type string. Last error was: %s\n", err)
+	}
+	if len(typed) != 1 || typed[0] != '☃' {
+		t.Fatalf("Expected the hook to type ☃, got %q", string(typed))
+	}
+	assertEvents(t, readTestEvents(t, vk.deviceFile), keyTaps(KeyA, KeyX))
+
+	if _, ok := vk.TypeString("€").(*UnsupportedRunesError); !ok {
+		t.Fatalf("Expected a rune the hook doesn't support to be unsupported")
+	}
+}
+
+func TestUnicodeFallbacksUseFirstMatch(t *testing.T) {
+	vk := createTestKeyboard(t, KeyboardConfig{})
+	defer removeTestDeviceFile(vk.deviceFile)
+	vk.fallbacks = []UnicodeFallback{ComposeInput{}, UnicodeHexInput{}}
+
+	// © has a compose sequence, ☃ has none and is typed by the second fallback
+	if err := vk.TypeString("©☃"); err != nil {
+		t.Fatalf("Failed to type string. Last error was: %s\n", err)
+	}
+	events := readTestEvents(t, vk.deviceFile)
+	assertEvents(t, events[:6], keyTaps(KeyCompose, KeyO, KeyC))
+	if events[6].Code != KeyLeftctrl {
+		t.Fatalf("Expected ☃ to be typed using Ctrl+Shift+U, got %v", events[6:])
+	}
+}
+
+func TestUnicodeFallbacksSkipSequencesTheKeyboardCantType(t *testing.T) {
+	vk := createTestKeyboard(t, KeyboardConfig{})
+	defer removeTestDeviceFile(vk.deviceFile)
+	// ü is not on the US layout, so the compose sequence can't be typed
+	compose := ComposeInput{Sequences: map[rune]string{'☃': "üs"}}
+
+	vk.fallbacks = []UnicodeFallback{compose}
+	if _, ok := vk.TypeString("a☃").(*UnsupportedRunesError); !ok {
+		t.Fatalf("Expected ☃ to be unsupported")
+	}
+	if events := readTestEvents(t, vk.deviceFile); len(events) != 0 {
+		t.Fatalf("Expected nothing to be typed, got %v", events)
+	}
+
+	vk.fallbacks = []UnicodeFallback{compose, UnicodeHexInput{}}
+	if err := vk.TypeString("☃"); err != nil {
+		t.Fatalf("Failed to type string. Last error was: %s\n", err)
+	}
+	if events := readTestEvents(t, vk.deviceFile); events[0].Code != KeyLeftctrl {
+		t.Fatalf("Expected ☃ to be typed using Ctrl+Shift+U, got %v", events)
+	}
+}
+
+func TestComposeInputNeedsRegisteredComposeKey(t *testing.T) {
+	vk := createTestKeyboard(t, KeyboardConfig{})
+	defer removeTestDeviceFile(vk.deviceFile)
+	delete(vk.keys, KeyCompose)
+	vk.fallbacks = []UnicodeFallback{ComposeInput{}}
+
+	if _, ok := vk.TypeString("©").(*UnsupportedRunesError); !ok {
+		t.Fatalf("Expected © to be unsupported without compose key")
+	}
+	if events := readTestEvents(t, vk.deviceFile); len(events) != 0 {
+		t.Fatalf("Expected nothing to be typed, got %v", events)
+	}
+}
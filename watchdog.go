@@ -0,0 +1,183 @@
+package uinput
+
+import (
+	"fmt"
+	"os"
+	"sort"
+	"sync"
+	"time"
+)
+
+// StuckReason tells why the watchdog released a key or button.
+type StuckReason int
+
+const (
+	// StuckMaxHold means the key was held down longer than WatchdogConfig.MaxHold.
+	StuckMaxHold StuckReason = iota + 1
+	// StuckNoHeartbeat means the application did not call Heartbeat within WatchdogConfig.HeartbeatTimeout.
+	StuckNoHeartbeat
+)
+
+func (r StuckReason) String() string {
+	switch r {
+	case StuckMaxHold:
+		return "held too long"
+	case StuckNoHeartbeat:
+		return "no heartbeat"
+	}
+	return fmt.Sprintf("StuckReason(%d)", int(r))
+}
+
+// StuckInput describes a key or button released by the watchdog.
+type StuckInput struct {
+	// Code of the key or button.
+	Code int
+
+	// HeldFor is the time the key was held down.
+	HeldFor time.Duration
+
+	Reason StuckReason
+
+	// Err is the error of sending the release, if any.
+	Err error
+}
+
+// WatchdogConfig holds the settings of a watchdog started with Watch. At least one of MaxHold and
+// HeartbeatTimeout has to be set.
+type WatchdogConfig struct {
+	// MaxHold is the longest time a key or button may be held down.
+	MaxHold time.Duration
+
+	// HeartbeatTimeout releases every key and button held down if the application did not call
+	// Heartbeat for the given time.
+	HeartbeatTimeout time.Duration
+
+	// Interval is the time between two checks. Defaults to a tenth of the shortest timeout.
+	Interval time.Duration
+
+	// OnRelease is called (from the watchdog's goroutine) for every key or button the watchdog released.
+	OnRelease func(StuckInput)
+}
+
+// A Watchdog releases keys and buttons of a device that are stuck, e.g. because the application
+// crashed in the middle of a script.
+type Watchdog struct {
+	config        WatchdogConfig
+	device        watchable
+	state         *inputState
+	mu            sync.Mutex
+	lastHeartbeat time.Time
+	stop          chan struct{}
+	stopOnce      sync.Once
+}
+
+// watchable is implemented by the devices that keep track of the keys and buttons held down.
+type watchable interface {
+	trackedState() (*os.File, *inputState)
+
+	// releaseTracked releases a key or button the way the device's own methods do, holding the
+	// same lock, so the release can't end up in the middle of another frame. The key is only
+	// released if it is still held down since the given time. It reports whether it was.
+	releaseTracked(code int, since time.Time) (bool, error)
+}
+
+// Watch starts a watchdog for the given device (a Keyboard, Mouse, TouchPad or Gamepad). The
+// watchdog runs until Stop is called or the device is closed.
+func Watch(device interface{}, config WatchdogConfig) (*Watchdog, error) {
+	w, ok := device.(watchable)
+	if !ok {
+		return nil, fmt.Errorf("failed to start watchdog: %T does not keep track of held inputs", device)
+	}
+	if config.MaxHold <= 0 && config.HeartbeatTimeout <= 0 {
+		return nil, fmt.Errorf("failed to start watchdog: neither MaxHold nor HeartbeatTimeout is set")
+	}
+	interval := config.Interval
+	if interval <= 0 {
+		shortest := config.MaxHold
+		if shortest <= 0 || (config.HeartbeatTimeout > 0 && config.HeartbeatTimeout < shortest) {
+			shortest = config.HeartbeatTimeout
+		}
+		interval = shortest / 10
+		if interval <= 0 {
+			interval = time.Millisecond
+		}
+	}
+
+	_, state := w.trackedState()
+	wd := &Watchdog{
+		config:        config,
+		device:        w,
+		state:         state,
+		lastHeartbeat: time.Now(),
+		stop:          make(chan struct{}),
+	}
+	go wd.run(interval)
+	return wd, nil
+}
+
+// Heartbeat tells the watchdog that the application is still alive.
+func (wd *Watchdog) Heartbeat() {
+	wd.mu.Lock()
+	wd.lastHeartbeat = time.Now()
+	wd.mu.Unlock()
+}
+
+// Stop stops the watchdog. Keys held down stay pressed.
+func (wd *Watchdog) Stop() {
+	wd.stopOnce.Do(func() {
+		close(wd.stop)
+	})
+}
+
+func (wd *Watchdog) run(interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-wd.stop:
+			return
+		case now := <-ticker.C:
+			if wd.state.isClosed() {
+				wd.Stop()
+				return
+			}
+			wd.check(now)
+		}
+	}
+}
+
+// check releases every key that is stuck at the given time.
+func (wd *Watchdog) check(now time.Time) {
+	wd.mu.Lock()
+	noHeartbeat := wd.config.HeartbeatTimeout > 0 && now.Sub(wd.lastHeartbeat) > wd.config.HeartbeatTimeout
+	wd.mu.Unlock()
+
+	pressed := wd.state.pressedSince()
+	keys := make([]int, 0, len(pressed))
+	for key := range pressed {
+		keys = append(keys, key)
+	}
+	sort.Ints(keys)
+
+	for _, key := range keys {
+		heldFor := now.Sub(pressed[key])
+		var reason StuckReason
+		switch {
+		case wd.config.MaxHold > 0 && heldFor > wd.config.MaxHold:
+			reason = StuckMaxHold
+		case noHeartbeat:
+			reason = StuckNoHeartbeat
+		default:
+			continue
+		}
+
+		held, err := wd.device.releaseTracked(key, pressed[key])
+		if !held {
+			// released (and maybe pressed again) by the application in the meantime
+			continue
+		}
+		if wd.config.OnRelease != nil {
+			wd.config.OnRelease(StuckInput{Code: key, HeldFor: heldFor, Reason: reason, Err: err})
+		}
+	}
+}
@@ -0,0 +1,163 @@
+package uinput
+
+import (
+	"os"
+	"testing"
+	"time"
+)
+
+func createTestWatchdog(t *testing.T, config WatchdogConfig) (*Watchdog, *inputState) {
+	state := newInputState()
+	mouse := vMouse{deviceFile: createTestDeviceFile(t), state: state}
+	wd := &Watchdog{config: config, device: mouse, state: state, lastHeartbeat: time.Now(), stop: make(chan struct{})}
+	return wd, state
+}
+
+// testWatchdogFile returns the device file of the device watched by a test watchdog.
+func testWatchdogFile(wd *Watchdog) *os.File {
+	file, _ := wd.device.trackedState()
+	return file
+}
+
+func TestWatchdogReleasesKeysHeldTooLong(t *testing.T) {
+	var released []StuckInput
+	wd, state := createTestWatchdog(t, WatchdogConfig{MaxHold: time.Second, OnRelease: func(s StuckInput) {
+		released = append(released, s)
+	}})
+	defer removeTestDeviceFile(testWatchdogFile(wd))
+
+	start := time.Now()
+	state.pressed[KeyA] = start
+	state.pressed[KeyB] = start.Add(900 * time.Millisecond)
+
+	wd.check(start.Add(1500 * time.Millisecond))
+
+	if len(released) != 1 || released[0].Code != KeyA || released[0].Reason != StuckMaxHold || released[0].HeldFor != 1500*time.Millisecond {
+		t.Fatalf("Expected KeyA to be released for being held too long, got %+v", released)
+	}
+	if state.isPressed(KeyA) || !state.isPressed(KeyB) {
+		t.Fatalf("Expected only KeyB to be held down, got %v", state.pressedKeys())
+	}
+	assertEvents(t, readTestEvents(t, testWatchdogFile(wd)), []inputEvent{{Type: EvKey, Code: KeyA, Value: btnStateReleased}})
+}
+
+func TestWatchdogReleasesKeysWithoutHeartbeat(t *testing.T) {
+	var released []StuckInput
+	wd, state := createTestWatchdog(t, WatchdogConfig{HeartbeatTimeout: time.Second, OnRelease: func(s StuckInput) {
+		released = append(released, s)
+	}})
+	defer removeTestDeviceFile(testWatchdogFile(wd))
+
+	state.setPressed(BtnLeft, true)
+	wd.Heartbeat()
+	wd.check(time.Now().Add(500 * time.Millisecond))
+	if len(released) != 0 {
+		t.Fatalf("Expected nothing to be released while the heartbeat is alive, got %+v", released)
+	}
+
+	wd.check(time.Now().Add(2 * time.Second))
+	if len(released) != 1 || released[0].Code != BtnLeft || released[0].Reason != StuckNoHeartbeat {
+		t.Fatalf("Expected BtnLeft to be released due to a missing heartbeat, got %+v", released)
+	}
+}
+
+func TestWatchRequiresTimeout(t *testing.T) {
+	vk := createTestKeyboard(t, KeyboardConfig{})
+	defer removeTestDeviceFile(vk.deviceFile)
+
+	_, err := Watch(vk, WatchdogConfig{})
+	if err == nil {
+		t.Fatalf("Expected Watch to fail without timeouts, but no error was returned.")
+	}
+	_, err = Watch(struct{}{}, WatchdogConfig{MaxHold: time.Second})
+	if err == nil {
+		t.Fatalf("Expected Watch to fail for an unsupported device, but no error was returned.")
+	}
+}
+
+func TestWatchReleasesStuckKey(t *testing.T) {
+	vk := createTestKeyboard(t, KeyboardConfig{})
+	defer removeTestDeviceFile(vk.deviceFile)
+
+	released := make(chan StuckInput, 1)
+	wd, err := Watch(vk, WatchdogConfig{MaxHold: 20 * time.Millisecond, OnRelease: func(s StuckInput) {
+		released <- s
+	}})
+	if err != nil {
+		t.Fatalf("Failed to start watchdog. Last error was: %s\n", err)
+	}
+	defer wd.Stop()
+
+	if err := vk.KeyDown(KeyLeftctrl); err != nil {
+		t.Fatalf("Failed to press key. Last error was: %s\n", err)
+	}
+	select {
+	case s := <-released:
+		if s.Code != KeyLeftctrl || s.Err != nil {
+			t.Fatalf("Unexpected release: %+v", s)
+		}
+	case <-time.After(time.Second):
+		t.Fatalf("Expected the watchdog to release the key")
+	}
+	if vk.IsPressed(KeyLeftctrl) {
+		t.Fatalf("Expected KeyLeftctrl to be released")
+	}
+}
+
+func TestWatchdogReleasesKeysLikeKeyUp(t *testing.T) {
+	vk := createTestKeyboard(t, KeyboardConfig{Scancodes: UsbKeyboardScancodes()})
+	defer removeTestDeviceFile(vk.deviceFile)
+	wd := &Watchdog{config: WatchdogConfig{MaxHold: time.Second}, device: vk, state: vk.state,
+		lastHeartbeat: time.Now(), stop: make(chan struct{})}
+
+	if err := vk.KeyDown(KeyA); err != nil {
+		t.Fatalf("Failed to press key. Last error was: %s\n", err)
+	}
+	wd.check(time.Now().Add(2 * time.Second))
+
+	scancode := UsbKeyboardScancodes()[KeyA]
+	assertEvents(t, readTestEvents(t, vk.deviceFile), []inputEvent{
+		{Type: EvMsc, Code: MscScan, Value: scancode},
+		{Type: EvKey, Code: KeyA, Value: btnStatePressed},
+		{Type: EvMsc, Code: MscScan, Value: scancode},
+		{Type: EvKey, Code: KeyA, Value: btnStateReleased},
+	})
+	if vk.IsPressed(KeyA) {
+		t.Fatalf("Expected KeyA to be released")
+	}
+}
+
+// repressingDevice presses the key again right before the watchdog releases it, like an
+// application that released and pressed the key after the watchdog looked at it.
+type repressingDevice struct {
+	vMouse
+}
+
+func (d repressingDevice) releaseTracked(code int, since time.Time) (bool, error) {
+	d.state.setPressed(code, false)
+	d.state.setPressed(code, true)
+	return d.vMouse.releaseTracked(code, since)
+}
+
+func TestWatchdogLeavesKeysPressedAgainAlone(t *testing.T) {
+	var released []StuckInput
+	wd, state := createTestWatchdog(t, WatchdogConfig{MaxHold: time.Second, OnRelease: func(s StuckInput) {
+		released = append(released, s)
+	}})
+	defer removeTestDeviceFile(testWatchdogFile(wd))
+	wd.device = repressingDevice{wd.device.(vMouse)}
+
+	start := time.Now().Add(-2 * time.Second)
+	state.pressed[BtnLeft] = start
+	wd.check(time.Now())
+
+	if len(released) != 0 {
+		t.Fatalf("Expected the fresh press not to be reported as stuck, got %+v", released)
+	}
+	if !state.isPressed(BtnLeft) {
+		t.Fatalf("Expected BtnLeft to stay pressed")
+	}
+	if events := readTestEvents(t, testWatchdogFile(wd)); len(events) != 0 {
+		t.Fatalf("Expected nothing to be sent, got %v", events)
+	}
+}